@@ -0,0 +1,97 @@
+package date
+
+import (
+	"time"
+)
+
+// Feb29Policy specifies on which date the anniversaries of February 29 fall in common years.
+type Feb29Policy int
+
+const (
+	// Feb29ToFeb28 moves anniversaries of February 29 to February 28 in common years.
+	// It is consistent with the end-of-month clamping of AddMonths, and is used by the Date methods.
+	Feb29ToFeb28 Feb29Policy = iota
+	// Feb29ToMar1 moves anniversaries of February 29 to March 1 in common years,
+	// as for the legal age in jurisdictions such as Japan and England.
+	Feb29ToMar1
+)
+
+// Conversion methods
+// --------------------------------------------------
+
+// AnniversaryIn returns the anniversary of the date in the specified year according to the policy.
+func (p Feb29Policy) AnniversaryIn(date Date, year int) Date {
+	if date.Month() == time.February && date.Day() == 29 && !NewYear(year).IsLeap() {
+		if p == Feb29ToMar1 {
+			return NewDate(year, time.March, 1)
+		}
+
+		return NewDate(year, time.February, 28)
+	}
+
+	return NewDate(year, date.Month(), date.Day())
+}
+
+// AgeOn returns the number of full years from the date to ref according to the policy.
+// It returns 0 if ref is before the date.
+func (p Feb29Policy) AgeOn(date, ref Date) int {
+	if ref.Before(date) {
+		return 0
+	}
+
+	age := ref.Year() - date.Year()
+	if ref.Before(p.AnniversaryIn(date, ref.Year())) {
+		age--
+	}
+
+	return age
+}
+
+// NextAnniversary returns the first anniversary of the date on or after from according to the policy.
+// The date itself is not an anniversary, so the result is at least one year after the date.
+func (p Feb29Policy) NextAnniversary(date, from Date) Date {
+	year := max(from.Year(), date.Year()+1)
+
+	next := p.AnniversaryIn(date, year)
+	if next.Before(from) {
+		return p.AnniversaryIn(date, year+1)
+	}
+
+	return next
+}
+
+// AnniversariesIn returns the anniversaries of the date within the DateRange according to the policy.
+// The date itself is not an anniversary.
+func (p Feb29Policy) AnniversariesIn(date Date, r DateRange) Dates {
+	ds := make(Dates, 0)
+
+	for year := max(r.start.Year(), date.Year()+1); year <= r.end.Year(); year++ {
+		if a := p.AnniversaryIn(date, year); r.Contains(a) {
+			ds = append(ds, a)
+		}
+	}
+
+	return ds
+}
+
+// Conversion methods of Date
+// --------------------------------------------------
+
+// AgeOn returns the number of full years from the Date instance to ref, such as the age of a person born on the date.
+// Anniversaries of February 29 fall on February 28 in common years; use Feb29Policy to choose otherwise.
+// It returns 0 if ref is before the Date instance.
+func (d Date) AgeOn(ref Date) int {
+	return Feb29ToFeb28.AgeOn(d, ref)
+}
+
+// NextAnniversary returns the first anniversary of the Date instance on or after from.
+// Anniversaries of February 29 fall on February 28 in common years; use Feb29Policy to choose otherwise.
+func (d Date) NextAnniversary(from Date) Date {
+	return Feb29ToFeb28.NextAnniversary(d, from)
+}
+
+// AnniversariesIn returns the anniversaries of the Date instance within the DateRange.
+// Anniversaries of February 29 fall on February 28 in common years; use Feb29Policy to choose otherwise.
+func (d Date) AnniversariesIn(r DateRange) Dates {
+	return Feb29ToFeb28.AnniversariesIn(d, r)
+}
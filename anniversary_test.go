@@ -0,0 +1,178 @@
+package date
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFeb29PolicyAnniversaryIn(t *testing.T) {
+	tests := []struct {
+		policy Feb29Policy
+		date   Date
+		year   int
+		want   string
+	}{
+		{Feb29ToFeb28, MustParse("2000-02-29"), 2023, "2023-02-28"},
+		{Feb29ToMar1, MustParse("2000-02-29"), 2023, "2023-03-01"},
+		{Feb29ToFeb28, MustParse("2000-02-29"), 2024, "2024-02-29"},
+		{Feb29ToMar1, MustParse("2000-02-29"), 2024, "2024-02-29"},
+		{Feb29ToMar1, MustParse("2000-02-28"), 2023, "2023-02-28"},
+		{Feb29ToFeb28, MustParse("1990-12-31"), 2023, "2023-12-31"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Feb29Policy(%d).AnniversaryIn(Date{"%s"}, %d)`, tt.policy, tt.date, tt.year)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.policy.AnniversaryIn(tt.date, tt.year).String())
+		})
+	}
+}
+
+func TestFeb29PolicyAgeOn(t *testing.T) {
+	tests := []struct {
+		policy Feb29Policy
+		date   Date
+		ref    Date
+		want   int
+	}{
+		{Feb29ToFeb28, MustParse("2000-05-10"), MustParse("2024-05-09"), 23},
+		{Feb29ToFeb28, MustParse("2000-05-10"), MustParse("2024-05-10"), 24},
+		{Feb29ToFeb28, MustParse("2004-02-29"), MustParse("2022-02-28"), 18},
+		{Feb29ToMar1, MustParse("2004-02-29"), MustParse("2022-02-28"), 17},
+		{Feb29ToMar1, MustParse("2004-02-29"), MustParse("2022-03-01"), 18},
+		{Feb29ToMar1, MustParse("2004-02-29"), MustParse("2024-02-29"), 20},
+		{Feb29ToFeb28, MustParse("2000-05-10"), MustParse("2000-05-10"), 0},
+		{Feb29ToFeb28, MustParse("2000-05-10"), MustParse("1999-05-10"), 0},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Feb29Policy(%d).AgeOn(Date{"%s"}, Date{"%s"})`, tt.policy, tt.date, tt.ref)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.policy.AgeOn(tt.date, tt.ref))
+		})
+	}
+}
+
+func TestFeb29PolicyNextAnniversary(t *testing.T) {
+	tests := []struct {
+		policy Feb29Policy
+		date   Date
+		from   Date
+		want   string
+	}{
+		{Feb29ToFeb28, MustParse("2000-05-10"), MustParse("2024-05-09"), "2024-05-10"},
+		{Feb29ToFeb28, MustParse("2000-05-10"), MustParse("2024-05-10"), "2024-05-10"},
+		{Feb29ToFeb28, MustParse("2000-05-10"), MustParse("2024-05-11"), "2025-05-10"},
+		{Feb29ToFeb28, MustParse("2000-05-10"), MustParse("2000-05-10"), "2001-05-10"},
+		{Feb29ToFeb28, MustParse("2000-05-10"), MustParse("1990-01-01"), "2001-05-10"},
+		{Feb29ToFeb28, MustParse("2004-02-29"), MustParse("2023-01-01"), "2023-02-28"},
+		{Feb29ToMar1, MustParse("2004-02-29"), MustParse("2023-01-01"), "2023-03-01"},
+		{Feb29ToMar1, MustParse("2004-02-29"), MustParse("2023-03-02"), "2024-02-29"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Feb29Policy(%d).NextAnniversary(Date{"%s"}, Date{"%s"})`, tt.policy, tt.date, tt.from)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.policy.NextAnniversary(tt.date, tt.from).String())
+		})
+	}
+}
+
+func TestFeb29PolicyAnniversariesIn(t *testing.T) {
+	tests := []struct {
+		policy Feb29Policy
+		date   Date
+		r      DateRange
+		want   []string
+	}{
+		{
+			Feb29ToFeb28,
+			MustParse("2000-05-10"),
+			MustNewDateRange(MustParse("2022-06-01"), MustParse("2025-05-10")),
+			[]string{"2023-05-10", "2024-05-10", "2025-05-10"},
+		},
+		{
+			Feb29ToFeb28,
+			MustParse("2000-05-10"),
+			MustNewDateRange(MustParse("1999-01-01"), MustParse("2001-12-31")),
+			[]string{"2001-05-10"},
+		},
+		{
+			Feb29ToFeb28,
+			MustParse("2004-02-29"),
+			MustNewDateRange(MustParse("2023-01-01"), MustParse("2024-12-31")),
+			[]string{"2023-02-28", "2024-02-29"},
+		},
+		{
+			Feb29ToMar1,
+			MustParse("2004-02-29"),
+			MustNewDateRange(MustParse("2023-01-01"), MustParse("2024-12-31")),
+			[]string{"2023-03-01", "2024-02-29"},
+		},
+		{
+			Feb29ToFeb28,
+			MustParse("2000-05-10"),
+			MustNewDateRange(MustParse("2024-05-11"), MustParse("2025-05-09")),
+			[]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Feb29Policy(%d).AnniversariesIn(Date{"%s"}, DateRange{"%s"})`, tt.policy, tt.date, tt.r)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.policy.AnniversariesIn(tt.date, tt.r).Strings())
+		})
+	}
+}
+
+func TestDateAgeOn(t *testing.T) {
+	tests := []struct {
+		date Date
+		ref  Date
+		want int
+	}{
+		{MustParse("2000-05-10"), MustParse("2024-05-09"), 23},
+		{MustParse("2004-02-29"), MustParse("2022-02-28"), 18},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Date{"%s"}.AgeOn(Date{"%s"})`, tt.date, tt.ref)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.date.AgeOn(tt.ref))
+		})
+	}
+}
+
+func TestDateNextAnniversary(t *testing.T) {
+	tests := []struct {
+		date Date
+		from Date
+		want string
+	}{
+		{MustParse("2000-05-10"), MustParse("2024-05-11"), "2025-05-10"},
+		{MustParse("2004-02-29"), MustParse("2025-01-01"), "2025-02-28"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Date{"%s"}.NextAnniversary(Date{"%s"})`, tt.date, tt.from)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.date.NextAnniversary(tt.from).String())
+		})
+	}
+}
+
+func TestDateAnniversariesIn(t *testing.T) {
+	t.Run(`Date{"2004-02-29"}.AnniversariesIn(DateRange{"2023-01-01/2024-12-31"})`, func(t *testing.T) {
+		r := MustNewDateRange(MustParse("2023-01-01"), MustParse("2024-12-31"))
+
+		assert.Equal(t, []string{"2023-02-28", "2024-02-29"}, MustParse("2004-02-29").AnniversariesIn(r).Strings())
+	})
+}
@@ -0,0 +1,126 @@
+package date
+
+import "fmt"
+
+// maxNonBusinessDays is the longest run of consecutive non-business days that the business day methods search through.
+const maxNonBusinessDays = 3 * 366
+
+var (
+	ErrNoBusinessDay = fmt.Errorf("no business day found")
+)
+
+// Calendar decides which dates are holidays.
+// Weekends are handled separately by the business day methods, so a Calendar only needs to report holidays.
+type Calendar interface {
+	IsHoliday(date Date) bool
+}
+
+// NamedCalendar is a Calendar that can also tell the name of a holiday.
+type NamedCalendar interface {
+	Calendar
+	HolidayName(date Date) (string, bool)
+}
+
+// CalendarFunc is an adapter to allow the use of ordinary functions as a Calendar.
+type CalendarFunc func(date Date) bool
+
+// IsHoliday calls f(date).
+func (f CalendarFunc) IsHoliday(date Date) bool {
+	return f(date)
+}
+
+// Determination methods
+// --------------------------------------------------
+
+// IsHoliday checks if the Date instance is a holiday in the specified Calendar.
+// A nil Calendar has no holidays.
+func (d Date) IsHoliday(cal Calendar) bool {
+	if cal == nil {
+		return false
+	}
+
+	return cal.IsHoliday(d)
+}
+
+// HolidayName returns the name of the holiday on the Date instance in the specified NamedCalendar.
+// The second return value reports whether the Date instance is a holiday.
+func (d Date) HolidayName(cal NamedCalendar) (string, bool) {
+	if cal == nil {
+		return "", false
+	}
+
+	return cal.HolidayName(d)
+}
+
+// IsBusinessDay checks if the Date instance is a weekday and not a holiday in the specified Calendar.
+// A nil Calendar has no holidays, so only weekends are excluded.
+func (d Date) IsBusinessDay(cal Calendar) bool {
+	return d.IsWeekday() && !d.IsHoliday(cal)
+}
+
+// Addition and Subtraction methods
+// --------------------------------------------------
+
+// AddBusinessDays adds the specified number of business days to the Date instance.
+// Negative values move backwards. The Date instance itself is never counted,
+// so the result does not depend on whether it is a business day, and 0 returns the Date instance unchanged.
+// It panics with ErrNoBusinessDay if the Calendar reports no business day for about three years in a row,
+// which only happens with a broken Calendar such as one that treats every date as a holiday.
+func (d Date) AddBusinessDays(days int, cal Calendar) Date {
+	step := 1
+	if days < 0 {
+		step, days = -1, -days
+	}
+
+	date := d
+	skipped := 0
+	for days > 0 {
+		date = date.AddDays(step)
+		if date.IsBusinessDay(cal) {
+			days--
+			skipped = 0
+
+			continue
+		}
+
+		skipped++
+		if skipped > maxNonBusinessDays {
+			panic(fmt.Errorf("AddBusinessDays: %d consecutive days from %s: %w", skipped, d, ErrNoBusinessDay))
+		}
+	}
+
+	return date
+}
+
+// SubBusinessDays subtracts the specified number of business days from the Date instance.
+func (d Date) SubBusinessDays(days int, cal Calendar) Date {
+	return d.AddBusinessDays(days*-1, cal)
+}
+
+// NextBusinessDay returns the first business day after the Date instance.
+// Like AddBusinessDays, it panics with ErrNoBusinessDay if the Calendar has no business day to find.
+func (d Date) NextBusinessDay(cal Calendar) Date {
+	return d.AddBusinessDays(1, cal)
+}
+
+// PreviousBusinessDay returns the last business day before the Date instance.
+// Like AddBusinessDays, it panics with ErrNoBusinessDay if the Calendar has no business day to find.
+func (d Date) PreviousBusinessDay(cal Calendar) Date {
+	return d.AddBusinessDays(-1, cal)
+}
+
+// Conversion methods
+// --------------------------------------------------
+
+// BusinessDays returns the business days within the DateRange instance.
+func (r DateRange) BusinessDays(cal Calendar) Dates {
+	ds := make(Dates, 0, r.Days())
+
+	for d := r.start; d.BeforeOrEqual(r.end); d = d.AddDay() {
+		if d.IsBusinessDay(cal) {
+			ds = append(ds, d)
+		}
+	}
+
+	return ds
+}
@@ -0,0 +1,218 @@
+package date
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testHolidays(dates ...string) Calendar {
+	holidays := make(map[string]bool, len(dates))
+	for _, d := range dates {
+		holidays[d] = true
+	}
+
+	return CalendarFunc(func(date Date) bool {
+		return holidays[date.String()]
+	})
+}
+
+func TestCalendarFuncIsHoliday(t *testing.T) {
+	cal := testHolidays("2024-06-05")
+
+	t.Run("CalendarFunc.IsHoliday()", func(t *testing.T) {
+		assert.True(t, cal.IsHoliday(MustParse("2024-06-05")))
+		assert.False(t, cal.IsHoliday(MustParse("2024-06-06")))
+	})
+}
+
+// Determination methods
+// --------------------------------------------------
+
+func TestDateIsHoliday(t *testing.T) {
+	tests := []struct {
+		date Date
+		cal  Calendar
+		want bool
+	}{
+		{MustParse("2024-06-05"), testHolidays("2024-06-05"), true},
+		{MustParse("2024-06-06"), testHolidays("2024-06-05"), false},
+		{MustParse("2024-06-05"), nil, false},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Date{"%s"}.IsHoliday(%v)`, tt.date, tt.cal != nil)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.date.IsHoliday(tt.cal))
+		})
+	}
+}
+
+func TestDateIsBusinessDay(t *testing.T) {
+	tests := []struct {
+		date Date
+		cal  Calendar
+		want bool
+	}{
+		{MustParse("2024-06-05"), nil, true},
+		{MustParse("2024-06-08"), nil, false},
+		{MustParse("2024-06-09"), nil, false},
+		{MustParse("2024-06-05"), testHolidays("2024-06-05"), false},
+		{MustParse("2024-06-06"), testHolidays("2024-06-05"), true},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Date{"%s"}.IsBusinessDay(%v)`, tt.date, tt.cal != nil)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.date.IsBusinessDay(tt.cal))
+		})
+	}
+}
+
+// Addition and Subtraction methods
+// --------------------------------------------------
+
+func TestDateAddBusinessDays(t *testing.T) {
+	cal := testHolidays("2024-06-10", "2024-06-03")
+
+	tests := []struct {
+		date string
+		days int
+		want string
+	}{
+		{"2024-06-05", 0, "2024-06-05"},
+		{"2024-06-08", 0, "2024-06-08"},
+		{"2024-06-05", 1, "2024-06-06"},
+		{"2024-06-06", 2, "2024-06-11"},
+		{"2024-06-08", 1, "2024-06-11"},
+		{"2024-06-10", 1, "2024-06-11"},
+		{"2024-06-05", -1, "2024-06-04"},
+		{"2024-06-05", -2, "2024-05-31"},
+		{"2024-06-09", -1, "2024-06-07"},
+		{"2024-06-10", -1, "2024-06-07"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Date{"%s"}.AddBusinessDays(%d)`, tt.date, tt.days)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, MustParse(tt.date).AddBusinessDays(tt.days, cal).String())
+		})
+	}
+}
+
+func TestDateSubBusinessDays(t *testing.T) {
+	cal := testHolidays("2024-06-03")
+
+	tests := []struct {
+		date string
+		days int
+		want string
+	}{
+		{"2024-06-05", 0, "2024-06-05"},
+		{"2024-06-05", 2, "2024-05-31"},
+		{"2024-06-05", -2, "2024-06-07"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Date{"%s"}.SubBusinessDays(%d)`, tt.date, tt.days)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, MustParse(tt.date).SubBusinessDays(tt.days, cal).String())
+		})
+	}
+}
+
+func TestDateNextBusinessDay(t *testing.T) {
+	cal := testHolidays("2024-06-10")
+
+	tests := []struct {
+		date string
+		want string
+	}{
+		{"2024-06-05", "2024-06-06"},
+		{"2024-06-07", "2024-06-11"},
+		{"2024-06-08", "2024-06-11"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Date{"%s"}.NextBusinessDay()`, tt.date)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, MustParse(tt.date).NextBusinessDay(cal).String())
+		})
+	}
+}
+
+func TestDatePreviousBusinessDay(t *testing.T) {
+	cal := testHolidays("2024-06-07")
+
+	tests := []struct {
+		date string
+		want string
+	}{
+		{"2024-06-05", "2024-06-04"},
+		{"2024-06-10", "2024-06-06"},
+		{"2024-06-09", "2024-06-06"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Date{"%s"}.PreviousBusinessDay()`, tt.date)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, MustParse(tt.date).PreviousBusinessDay(cal).String())
+		})
+	}
+}
+
+func TestDateAddBusinessDaysWithoutBusinessDay(t *testing.T) {
+	cal := CalendarFunc(func(Date) bool { return true })
+
+	for _, days := range []int{1, -1} {
+		testcase := fmt.Sprintf(`Date{"2024-06-05"}.AddBusinessDays(%d)`, days)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.PanicsWithError(t, fmt.Sprintf("AddBusinessDays: %d consecutive days from 2024-06-05: %s", maxNonBusinessDays+1, ErrNoBusinessDay), func() {
+				MustParse("2024-06-05").AddBusinessDays(days, cal)
+			})
+		})
+	}
+}
+
+// Conversion methods
+// --------------------------------------------------
+
+func TestDateRangeBusinessDays(t *testing.T) {
+	tests := []struct {
+		dr   DateRange
+		cal  Calendar
+		want []string
+	}{
+		{
+			MustParseDateRange("2024-06-07", "2024-06-11"),
+			nil,
+			[]string{"2024-06-07", "2024-06-10", "2024-06-11"},
+		},
+		{
+			MustParseDateRange("2024-06-07", "2024-06-11"),
+			testHolidays("2024-06-10"),
+			[]string{"2024-06-07", "2024-06-11"},
+		},
+		{
+			MustParseDateRange("2024-06-08", "2024-06-09"),
+			nil,
+			[]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`DateRange{"%s","%s"}.BusinessDays()`, tt.dr.start, tt.dr.end)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.dr.BusinessDays(tt.cal).Strings())
+		})
+	}
+}
@@ -0,0 +1,201 @@
+package date
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Factory functions
+// --------------------------------------------------
+
+func TestSystemClock(t *testing.T) {
+	SetTestNow(func() time.Time { return time.Date(2000, time.January, 1, 0, 0, 0, 0, time.Local) })
+	defer ResetTestNow()
+
+	c := SystemClock()
+
+	assert.WithinDuration(t, time.Now(), c.Now(), time.Minute)
+	assert.NotEqual(t, MustParse("2000-01-01"), c.Today())
+}
+
+func TestDefaultClock(t *testing.T) {
+	mocked := time.Date(2024, time.June, 5, 12, 0, 0, 0, time.Local)
+	SetTestNow(func() time.Time { return mocked })
+	defer ResetTestNow()
+
+	c := DefaultClock()
+
+	assert.Equal(t, mocked, c.Now())
+	assert.Equal(t, MustParse("2024-06-05"), c.Today())
+	assert.Equal(t, MustParseMonth("2024-06"), c.CurrentMonth())
+}
+
+func TestNewFakeClock(t *testing.T) {
+	mocked := time.Date(2024, time.June, 5, 12, 0, 0, 0, time.Local)
+
+	c := NewFakeClock(mocked)
+
+	assert.Equal(t, mocked, c.Now())
+	assert.Equal(t, MustParse("2024-06-05"), c.Today())
+	assert.Equal(t, MustParseMonth("2024-06"), c.CurrentMonth())
+}
+
+func TestNewFakeClockAt(t *testing.T) {
+	c := NewFakeClockAt(MustParse("2024-06-05"))
+
+	assert.Equal(t, MustParse("2024-06-05"), c.Today())
+}
+
+// Modifier methods of FakeClock
+// --------------------------------------------------
+
+func TestFakeClockSet(t *testing.T) {
+	c := NewFakeClockAt(MustParse("2024-06-05"))
+
+	c.Set(time.Date(2024, time.December, 31, 23, 59, 0, 0, time.Local))
+
+	assert.Equal(t, MustParse("2024-12-31"), c.Today())
+}
+
+func TestFakeClockAdvance(t *testing.T) {
+	tests := []struct {
+		date Date
+		days int
+		want Date
+	}{
+		{MustParse("2024-06-05"), 1, MustParse("2024-06-06")},
+		{MustParse("2024-06-30"), 1, MustParse("2024-07-01")},
+		{MustParse("2024-06-05"), 0, MustParse("2024-06-05")},
+		{MustParse("2024-06-05"), -5, MustParse("2024-05-31")},
+		{MustParse("2024-02-28"), 366, MustParse("2025-02-28")},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`NewFakeClockAt(Date{"%s"}).Advance(%d)`, tt.date, tt.days)
+
+		t.Run(testcase, func(t *testing.T) {
+			c := NewFakeClockAt(tt.date)
+			c.Advance(tt.days)
+
+			assert.Equal(t, tt.want, c.Today())
+		})
+	}
+}
+
+func TestFakeClockAdvanceDuration(t *testing.T) {
+	c := NewFakeClock(time.Date(2024, time.June, 5, 23, 0, 0, 0, time.Local))
+
+	c.AdvanceDuration(2 * time.Hour)
+
+	assert.Equal(t, MustParse("2024-06-06"), c.Today())
+}
+
+func TestFakeClockConcurrency(t *testing.T) {
+	c := NewFakeClockAt(MustParse("2024-06-05"))
+
+	done := make(chan struct{})
+	for i := 0; i < 10; i++ {
+		go func() {
+			defer func() { done <- struct{}{} }()
+
+			for j := 0; j < 100; j++ {
+				c.Advance(1)
+				_ = c.Today()
+			}
+		}()
+	}
+	for i := 0; i < 10; i++ {
+		<-done
+	}
+
+	assert.Equal(t, MustParse("2024-06-05").AddDays(1000), c.Today())
+}
+
+// Clock-aware determination methods
+// --------------------------------------------------
+
+func TestDateIsTodayWith(t *testing.T) {
+	tests := []struct {
+		today Date
+		date  Date
+		want  [7]bool // past, pastOrToday, future, futureOrToday, today, yesterday, tomorrow
+	}{
+		{MustParse("2024-06-05"), MustParse("2024-06-04"), [7]bool{true, true, false, false, false, true, false}},
+		{MustParse("2024-06-05"), MustParse("2024-06-05"), [7]bool{false, true, false, true, true, false, false}},
+		{MustParse("2024-06-05"), MustParse("2024-06-06"), [7]bool{false, false, true, true, false, false, true}},
+		{MustParse("2030-01-01"), MustParse("2024-06-05"), [7]bool{true, true, false, false, false, false, false}},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Date{"%s"} on %s`, tt.date, tt.today)
+
+		t.Run(testcase, func(t *testing.T) {
+			t.Parallel()
+
+			c := NewFakeClockAt(tt.today)
+
+			assert.Equal(t, tt.want, [7]bool{
+				tt.date.IsPastWith(c),
+				tt.date.IsPastOrTodayWith(c),
+				tt.date.IsFutureWith(c),
+				tt.date.IsFutureOrTodayWith(c),
+				tt.date.IsTodayWith(c),
+				tt.date.IsYesterdayWith(c),
+				tt.date.IsTomorrowWith(c),
+			})
+		})
+	}
+}
+
+func TestMonthIsCurrentMonthWith(t *testing.T) {
+	tests := []struct {
+		today Date
+		month Month
+		want  [5]bool // past, future, current, next, last
+	}{
+		{MustParse("2024-06-05"), MustParseMonth("2024-05"), [5]bool{true, false, false, false, true}},
+		{MustParse("2024-06-05"), MustParseMonth("2024-06"), [5]bool{false, false, true, false, false}},
+		{MustParse("2024-06-05"), MustParseMonth("2024-07"), [5]bool{false, true, false, true, false}},
+		{MustParse("2024-12-31"), MustParseMonth("2025-01"), [5]bool{false, true, false, true, false}},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Month{"%s"} on %s`, tt.month, tt.today)
+
+		t.Run(testcase, func(t *testing.T) {
+			t.Parallel()
+
+			c := NewFakeClockAt(tt.today)
+
+			assert.Equal(t, tt.want, [5]bool{
+				tt.month.IsPastWith(c),
+				tt.month.IsFutureWith(c),
+				tt.month.IsCurrentMonthWith(c),
+				tt.month.IsNextMonthWith(c),
+				tt.month.IsLastMonthWith(c),
+			})
+		})
+	}
+}
+
+func TestWeekQuarterYearIsCurrentWith(t *testing.T) {
+	c := NewFakeClockAt(MustParse("2024-06-05"))
+
+	assert.True(t, WeekFromDate(MustParse("2024-06-05")).IsCurrentWeekWith(c))
+	assert.True(t, WeekFromDate(MustParse("2024-06-12")).IsNextWeekWith(c))
+	assert.True(t, WeekFromDate(MustParse("2024-05-29")).IsLastWeekWith(c))
+	assert.True(t, WeekFromDate(MustParse("2024-05-29")).IsPastWith(c))
+
+	assert.True(t, QuarterFromDate(MustParse("2024-06-05")).IsCurrentQuarterWith(c))
+	assert.True(t, QuarterFromDate(MustParse("2024-07-01")).IsNextQuarterWith(c))
+	assert.True(t, QuarterFromDate(MustParse("2024-03-31")).IsLastQuarterWith(c))
+	assert.True(t, QuarterFromDate(MustParse("2024-07-01")).IsFutureWith(c))
+
+	assert.True(t, YearFromDate(MustParse("2024-06-05")).IsCurrentYearWith(c))
+	assert.True(t, YearFromDate(MustParse("2025-01-01")).IsNextYearWith(c))
+	assert.True(t, YearFromDate(MustParse("2023-12-31")).IsLastYearWith(c))
+	assert.False(t, YearFromDate(MustParse("2024-01-01")).IsPastWith(c))
+}
@@ -148,37 +148,72 @@ func (d Date) IsWeekend() bool {
 
 // IsPast checks if the Date instance is in the past.
 func (d Date) IsPast() bool {
-	return d.Before(Today())
+	return d.IsPastWith(DefaultClock())
+}
+
+// IsPastWith checks if the Date instance is in the past according to the specified Clock.
+func (d Date) IsPastWith(c Clock) bool {
+	return d.Before(c.Today())
 }
 
 // IsPastOrToday checks if the Date instance is in the past or today.
 func (d Date) IsPastOrToday() bool {
-	return d.BeforeOrEqual(Today())
+	return d.IsPastOrTodayWith(DefaultClock())
+}
+
+// IsPastOrTodayWith checks if the Date instance is in the past or today according to the specified Clock.
+func (d Date) IsPastOrTodayWith(c Clock) bool {
+	return d.BeforeOrEqual(c.Today())
 }
 
 // IsFuture checks if the Date instance is in the future.
 func (d Date) IsFuture() bool {
-	return d.After(Today())
+	return d.IsFutureWith(DefaultClock())
+}
+
+// IsFutureWith checks if the Date instance is in the future according to the specified Clock.
+func (d Date) IsFutureWith(c Clock) bool {
+	return d.After(c.Today())
 }
 
 // IsFutureOrToday checks if the Date instance is in the future or today.
 func (d Date) IsFutureOrToday() bool {
-	return d.AfterOrEqual(Today())
+	return d.IsFutureOrTodayWith(DefaultClock())
+}
+
+// IsFutureOrTodayWith checks if the Date instance is in the future or today according to the specified Clock.
+func (d Date) IsFutureOrTodayWith(c Clock) bool {
+	return d.AfterOrEqual(c.Today())
 }
 
 // IsToday checks if the Date instance is today.
 func (d Date) IsToday() bool {
-	return d.Equal(Today())
+	return d.IsTodayWith(DefaultClock())
+}
+
+// IsTodayWith checks if the Date instance is today according to the specified Clock.
+func (d Date) IsTodayWith(c Clock) bool {
+	return d.Equal(c.Today())
 }
 
 // IsYesterday checks if the Date instance is yesterday.
 func (d Date) IsYesterday() bool {
-	return d.Equal(Yesterday())
+	return d.IsYesterdayWith(DefaultClock())
+}
+
+// IsYesterdayWith checks if the Date instance is yesterday according to the specified Clock.
+func (d Date) IsYesterdayWith(c Clock) bool {
+	return d.Equal(c.Today().SubDay())
 }
 
 // IsTomorrow checks if the Date instance is tomorrow.
 func (d Date) IsTomorrow() bool {
-	return d.Equal(Tomorrow())
+	return d.IsTomorrowWith(DefaultClock())
+}
+
+// IsTomorrowWith checks if the Date instance is tomorrow according to the specified Clock.
+func (d Date) IsTomorrowWith(c Clock) bool {
+	return d.Equal(c.Today().AddDay())
 }
 
 // Comparison methods
@@ -325,6 +360,17 @@ func (d Date) SubYears(years int) Date {
 	return d.AddYears(years * -1)
 }
 
+// AddPeriod adds the specified Period to the Date instance.
+// The years and months are added first with the same end-of-month clamping as AddMonths, and then the days.
+func (d Date) AddPeriod(period Period) Date {
+	return d.AddMonths(period.TotalMonths()).AddDays(period.Days())
+}
+
+// SubPeriod subtracts the specified Period from the Date instance.
+func (d Date) SubPeriod(period Period) Date {
+	return d.AddPeriod(period.Negated())
+}
+
 // StartOfMonth returns the first day of the month for the Date instance.
 func (d Date) StartOfMonth() Date {
 	return NewDate(d.Year(), d.Month(), 1)
@@ -348,11 +394,42 @@ func (d Date) EndOfYear() Date {
 // Conversion methods
 // --------------------------------------------------
 
+// Diff returns the Period from the Date instance to the specified date in years, months and days.
+// It is the inverse of AddPeriod, so d.AddPeriod(d.Diff(date)) always equals date.
+// The Period is negative if the specified date is before the Date instance.
+func (d Date) Diff(date Date) Period {
+	from, to := d.ToMonth(), date.ToMonth()
+	months := (to.y-from.y)*12 + to.m - from.m
+
+	if months > 0 && d.AddMonths(months).After(date) {
+		months--
+	} else if months < 0 && d.AddMonths(months).Before(date) {
+		months++
+	}
+
+	return NewPeriod(months/12, months%12, daysBetween(d.AddMonths(months), date))
+}
+
 // ToMonth converts the Date instance to a Month instance.
 func (d Date) ToMonth() Month {
 	return NewMonth(d.Year(), d.Month())
 }
 
+// ToWeek converts the Date instance to a Week instance of its ISO 8601 week.
+func (d Date) ToWeek() Week {
+	return WeekFromDate(d)
+}
+
+// ToQuarter converts the Date instance to a Quarter instance.
+func (d Date) ToQuarter() Quarter {
+	return QuarterFromDate(d)
+}
+
+// ToYear converts the Date instance to a Year instance.
+func (d Date) ToYear() Year {
+	return YearFromDate(d)
+}
+
 // Nullable converts the Date instance to a NullDate instance.
 func (d Date) Nullable() NullDate {
 	return NullDateFromDate(d)
@@ -1,8 +1,10 @@
 package date
 
 import (
+	"database/sql/driver"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 )
 
@@ -11,6 +13,8 @@ var (
 	ErrOnlyOneSideIsZero        = fmt.Errorf("only one side cannot be zero")
 	ErrEndDateIsBeforeStartDate = fmt.Errorf("end date is before start date")
 	ErrRangesDontOverlap        = fmt.Errorf("this range and target range don't overlap")
+	ErrInvalidSplitSize         = fmt.Errorf("split size must be positive")
+	ErrInvalidInterval          = fmt.Errorf("invalid ISO 8601 interval")
 )
 
 type DateRange struct {
@@ -123,6 +127,98 @@ func MustCustomParseDateRange(layout, start, end string) DateRange {
 	return r
 }
 
+// ParseInterval parses an ISO 8601 time interval of dates and returns a DateRange instance.
+// It supports "start/end" such as "2024-03-01/2024-03-31", "start/duration" such as "2024-03-01/P1M",
+// "duration/end" such as "P7D/2024-03-31", and reduced ends taking the missing components from the start,
+// such as "2024-03-01/15" and "2024-03-01/04-15".
+// Dates are treated as whole days, so a duration covers the days up to but excluding the date it reaches;
+// "2024-03-01/P1M" is March 2024 and "P7D/2024-03-31" starts on 2024-03-25.
+func ParseInterval(value string) (DateRange, error) {
+	start, end, found := strings.Cut(value, "/")
+	if !found {
+		return ZeroDateRange(), fmt.Errorf("ParseInterval: failed to parse %q: %w", value, ErrInvalidInterval)
+	}
+
+	var s, e Date
+	var err error
+
+	switch {
+	case isIntervalDuration(start) && isIntervalDuration(end):
+		return ZeroDateRange(), fmt.Errorf("ParseInterval: failed to parse %q: %w", value, ErrInvalidInterval)
+	case isIntervalDuration(start):
+		if e, err = Parse(end); err != nil {
+			return ZeroDateRange(), fmt.Errorf("ParseInterval: failed to parse end date: %w", err)
+		}
+
+		p, err := parseIntervalDuration(start)
+		if err != nil {
+			return ZeroDateRange(), fmt.Errorf("ParseInterval: %w", err)
+		}
+
+		s = e.AddDay().SubPeriod(p)
+	case isIntervalDuration(end):
+		if s, err = Parse(start); err != nil {
+			return ZeroDateRange(), fmt.Errorf("ParseInterval: failed to parse start date: %w", err)
+		}
+
+		p, err := parseIntervalDuration(end)
+		if err != nil {
+			return ZeroDateRange(), fmt.Errorf("ParseInterval: %w", err)
+		}
+
+		e = s.AddPeriod(p).SubDay()
+	default:
+		if s, err = Parse(start); err != nil {
+			return ZeroDateRange(), fmt.Errorf("ParseInterval: failed to parse start date: %w", err)
+		}
+
+		if len(end) == 2 || len(end) == 5 {
+			end = start[:len(start)-len(end)] + end
+		}
+
+		if e, err = Parse(end); err != nil {
+			return ZeroDateRange(), fmt.Errorf("ParseInterval: failed to parse end date: %w", err)
+		}
+	}
+
+	dr, err := NewDateRange(s, e)
+	if err != nil {
+		return ZeroDateRange(), fmt.Errorf("ParseInterval: %w", err)
+	}
+
+	return dr, nil
+}
+
+// MustParseInterval parses an ISO 8601 time interval of dates and returns a DateRange instance.
+// It panics if the parsing fails.
+func MustParseInterval(value string) DateRange {
+	r, err := ParseInterval(value)
+	if err != nil {
+		panic(err)
+	}
+
+	return r
+}
+
+// isIntervalDuration checks if the part of an ISO 8601 time interval is a duration.
+func isIntervalDuration(value string) bool {
+	return strings.HasPrefix(value, "P")
+}
+
+// parseIntervalDuration parses the duration of an ISO 8601 time interval, which must be positive.
+func parseIntervalDuration(value string) (Period, error) {
+	p, err := ParsePeriod(value)
+	if err != nil {
+		return ZeroPeriod(), fmt.Errorf("failed to parse duration: %w", err)
+	}
+
+	if p.IsZero() || p.IsNegative() {
+		return ZeroPeriod(), fmt.Errorf("duration %q must be positive: %w", value, ErrInvalidInterval)
+	}
+
+	return p, nil
+}
+
 // Determination methods
 // --------------------------------------------------
 
@@ -298,6 +394,76 @@ func (r DateRange) Dates() Dates {
 	return ds
 }
 
+// SplitByWeek splits the DateRange instance into weeks starting on the specified weekday.
+// The first and last DateRanges are clipped to the bounds of the DateRange instance.
+func (r DateRange) SplitByWeek(startWeekday time.Weekday) DateRanges {
+	return r.splitBy(func(d Date) Date {
+		return d.AddDays(6 - (int(d.Weekday())-int(startWeekday)+7)%7)
+	})
+}
+
+// SplitByMonth splits the DateRange instance into calendar months.
+// The first and last DateRanges are clipped to the bounds of the DateRange instance.
+func (r DateRange) SplitByMonth() DateRanges {
+	return r.splitBy(Date.EndOfMonth)
+}
+
+// SplitByQuarter splits the DateRange instance into calendar quarters.
+// The first and last DateRanges are clipped to the bounds of the DateRange instance.
+func (r DateRange) SplitByQuarter() DateRanges {
+	return r.splitBy(func(d Date) Date {
+		return d.ToQuarter().LastDate()
+	})
+}
+
+// SplitByYear splits the DateRange instance into calendar years.
+// The first and last DateRanges are clipped to the bounds of the DateRange instance.
+func (r DateRange) SplitByYear() DateRanges {
+	return r.splitBy(Date.EndOfYear)
+}
+
+// SplitEvery splits the DateRange instance into chunks of n days from the start date.
+// The last DateRange is clipped to the end date of the DateRange instance.
+// It returns ErrInvalidSplitSize if n is not positive.
+func (r DateRange) SplitEvery(n int) (DateRanges, error) {
+	if n <= 0 {
+		return nil, fmt.Errorf("SplitEvery: %d days: %w", n, ErrInvalidSplitSize)
+	}
+
+	return r.splitBy(func(d Date) Date {
+		return d.AddDays(n - 1)
+	}), nil
+}
+
+// MustSplitEvery splits the DateRange instance into chunks of n days from the start date.
+// It panics if n is not positive.
+func (r DateRange) MustSplitEvery(n int) DateRanges {
+	drs, err := r.SplitEvery(n)
+	if err != nil {
+		panic(err)
+	}
+
+	return drs
+}
+
+// splitBy splits the DateRange instance into consecutive chunks,
+// where endOf returns the last date of the chunk starting on the specified date.
+func (r DateRange) splitBy(endOf func(Date) Date) DateRanges {
+	drs := make(DateRanges, 0)
+
+	for start := r.start; start.BeforeOrEqual(r.end); {
+		end := endOf(start)
+		if end.After(r.end) {
+			end = r.end
+		}
+
+		drs = append(drs, DateRange{start, end})
+		start = end.AddDay()
+	}
+
+	return drs
+}
+
 // String returns the string representation of the DateRange instance in the format "start/end".
 func (r DateRange) String() string {
 	return r.start.String() + "/" + r.end.String()
@@ -306,6 +472,62 @@ func (r DateRange) String() string {
 // Marshalling methods
 // --------------------------------------------------
 
+// Value returns the driver.Value representation of the DateRange instance as a PostgreSQL daterange literal
+// in the canonical half-open form such as "[2024-03-01,2024-04-01)".
+// A zero DateRange is represented as "empty".
+func (r DateRange) Value() (driver.Value, error) {
+	if r.IsZero() {
+		return "empty", nil
+	}
+
+	return formatRangeLiteral(NullDateFromDate(r.start), NullDateFromDate(r.end)), nil
+}
+
+// Scan scans a PostgreSQL daterange value into the DateRange instance.
+// It accepts any combination of inclusive and exclusive bounds, converting them to a closed range,
+// and scans "empty" as a zero DateRange. It returns an error if either bound is infinite.
+func (r *DateRange) Scan(value interface{}) error {
+	rl, ok, err := scanRangeLiteral(value)
+	if err != nil {
+		return fmt.Errorf("DateRange.Scan: %w", err)
+	}
+	if !ok {
+		return nil
+	}
+
+	if rl.empty {
+		*r = ZeroDateRange()
+
+		return nil
+	}
+
+	if rl.start.IsNull() || rl.last.IsNull() {
+		return fmt.Errorf("DateRange.Scan: %w", ErrDateRangeIsUnbounded)
+	}
+
+	*r = DateRange{rl.start.date, rl.last.date}
+
+	return nil
+}
+
+// MarshalText marshals the DateRange instance to a text representation in the format "start/end".
+func (r *DateRange) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+// UnmarshalText unmarshals a text representation into the DateRange instance.
+// It accepts any ISO 8601 time interval supported by ParseInterval.
+func (r *DateRange) UnmarshalText(text []byte) error {
+	dr, err := ParseInterval(string(text))
+	if err != nil {
+		return fmt.Errorf("DateRange.UnmarshalText: %w", err)
+	}
+
+	*r = dr
+
+	return nil
+}
+
 // MarshalJSON marshals the DateRange instance to a JSON representation.
 func (r DateRange) MarshalJSON() ([]byte, error) {
 	return json.Marshal(struct {
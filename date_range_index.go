@@ -0,0 +1,276 @@
+package date
+
+// DateRangeIndex is an interval tree of DateRange instances answering overlap queries in O(log n + k) time,
+// where k is the number of results.
+// It is an AVL tree ordered by start and end dates, in which each node keeps the latest end date of its subtree.
+// Identical DateRange instances are stored once with their multiplicity.
+// The zero value is an empty index ready to use. It is not safe for concurrent use.
+type DateRangeIndex struct {
+	root *dateRangeNode
+	size int
+}
+
+// dateRangeNode is a node of DateRangeIndex.
+type dateRangeNode struct {
+	r      DateRange
+	count  int
+	maxEnd Date
+	height int
+	left   *dateRangeNode
+	right  *dateRangeNode
+}
+
+// Factory functions
+// --------------------------------------------------
+
+// NewDateRangeIndex creates a new DateRangeIndex instance containing the DateRanges.
+func NewDateRangeIndex(ranges DateRanges) *DateRangeIndex {
+	sorted := ranges.Sort()
+	nodes := make([]*dateRangeNode, 0, len(sorted))
+
+	for _, r := range sorted {
+		if last := len(nodes) - 1; last >= 0 && nodes[last].r.Equal(r) {
+			nodes[last].count++
+
+			continue
+		}
+
+		nodes = append(nodes, &dateRangeNode{r: r, count: 1})
+	}
+
+	return &DateRangeIndex{
+		root: buildDateRangeNodes(nodes),
+		size: len(sorted),
+	}
+}
+
+// buildDateRangeNodes builds a balanced tree from the sorted nodes.
+func buildDateRangeNodes(nodes []*dateRangeNode) *dateRangeNode {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	mid := len(nodes) / 2
+	n := nodes[mid]
+	n.left = buildDateRangeNodes(nodes[:mid])
+	n.right = buildDateRangeNodes(nodes[mid+1:])
+
+	return n.update()
+}
+
+// Modifier methods
+// --------------------------------------------------
+
+// Insert adds the DateRange to the DateRangeIndex instance.
+func (ix *DateRangeIndex) Insert(r DateRange) {
+	ix.root = ix.root.insert(r)
+	ix.size++
+}
+
+// Delete removes one occurrence of the DateRange from the DateRangeIndex instance.
+// It reports whether the DateRange was found.
+func (ix *DateRangeIndex) Delete(r DateRange) bool {
+	root, ok := ix.root.delete(r)
+	ix.root = root
+
+	if ok {
+		ix.size--
+	}
+
+	return ok
+}
+
+// Conversion methods
+// --------------------------------------------------
+
+// Len returns the number of DateRange instances in the DateRangeIndex instance, including duplicates.
+func (ix *DateRangeIndex) Len() int {
+	return ix.size
+}
+
+// Stabbing returns the DateRange instances containing the specified date in ascending order.
+func (ix *DateRangeIndex) Stabbing(date Date) DateRanges {
+	return ix.Overlapping(DateRange{date, date})
+}
+
+// Overlapping returns the DateRange instances overlapping with the specified DateRange in ascending order.
+func (ix *DateRangeIndex) Overlapping(r DateRange) DateRanges {
+	drs := make(DateRanges, 0)
+	ix.root.collectOverlapping(r, &drs)
+
+	return drs
+}
+
+// DateRanges returns all DateRange instances in the DateRangeIndex instance in ascending order.
+func (ix *DateRangeIndex) DateRanges() DateRanges {
+	drs := make(DateRanges, 0, ix.size)
+	ix.root.collect(&drs)
+
+	return drs
+}
+
+// Tree operations
+// --------------------------------------------------
+
+// compareDateRanges compares DateRange instances by their start dates and then by their end dates.
+func compareDateRanges(a, b DateRange) int {
+	if c := a.start.Compare(b.start); c != 0 {
+		return c
+	}
+
+	return a.end.Compare(b.end)
+}
+
+// heightOf returns the height of the node, which is 0 for nil.
+func (n *dateRangeNode) heightOf() int {
+	if n == nil {
+		return 0
+	}
+
+	return n.height
+}
+
+// update recalculates the height and the latest end date of the node from its children.
+func (n *dateRangeNode) update() *dateRangeNode {
+	n.height = max(n.left.heightOf(), n.right.heightOf()) + 1
+	n.maxEnd = n.r.end
+
+	if n.left != nil && n.left.maxEnd.After(n.maxEnd) {
+		n.maxEnd = n.left.maxEnd
+	}
+	if n.right != nil && n.right.maxEnd.After(n.maxEnd) {
+		n.maxEnd = n.right.maxEnd
+	}
+
+	return n
+}
+
+// rotateLeft rotates the subtree to the left and returns its new root.
+func (n *dateRangeNode) rotateLeft() *dateRangeNode {
+	r := n.right
+	n.right = r.left
+	r.left = n.update()
+
+	return r.update()
+}
+
+// rotateRight rotates the subtree to the right and returns its new root.
+func (n *dateRangeNode) rotateRight() *dateRangeNode {
+	l := n.left
+	n.left = l.right
+	l.right = n.update()
+
+	return l.update()
+}
+
+// balance restores the AVL property of the subtree and returns its new root.
+func (n *dateRangeNode) balance() *dateRangeNode {
+	n.update()
+
+	switch factor := n.left.heightOf() - n.right.heightOf(); {
+	case factor > 1:
+		if n.left.left.heightOf() < n.left.right.heightOf() {
+			n.left = n.left.rotateLeft()
+		}
+
+		return n.rotateRight()
+	case factor < -1:
+		if n.right.right.heightOf() < n.right.left.heightOf() {
+			n.right = n.right.rotateRight()
+		}
+
+		return n.rotateLeft()
+	}
+
+	return n
+}
+
+// insert adds the DateRange to the subtree and returns its new root.
+func (n *dateRangeNode) insert(r DateRange) *dateRangeNode {
+	if n == nil {
+		return (&dateRangeNode{r: r, count: 1}).update()
+	}
+
+	switch c := compareDateRanges(r, n.r); {
+	case c < 0:
+		n.left = n.left.insert(r)
+	case c > 0:
+		n.right = n.right.insert(r)
+	default:
+		n.count++
+
+		return n
+	}
+
+	return n.balance()
+}
+
+// delete removes one occurrence of the DateRange from the subtree and returns its new root.
+func (n *dateRangeNode) delete(r DateRange) (*dateRangeNode, bool) {
+	if n == nil {
+		return nil, false
+	}
+
+	var ok bool
+
+	switch c := compareDateRanges(r, n.r); {
+	case c < 0:
+		n.left, ok = n.left.delete(r)
+	case c > 0:
+		n.right, ok = n.right.delete(r)
+	case n.count > 1:
+		n.count--
+
+		return n, true
+	case n.left == nil:
+		return n.right, true
+	case n.right == nil:
+		return n.left, true
+	default:
+		successor := n.right
+		for successor.left != nil {
+			successor = successor.left
+		}
+
+		n.r, n.count = successor.r, successor.count
+		successor.count = 1
+		n.right, _ = n.right.delete(successor.r)
+		ok = true
+	}
+
+	return n.balance(), ok
+}
+
+// collectOverlapping appends the DateRange instances in the subtree overlapping with r in ascending order.
+func (n *dateRangeNode) collectOverlapping(r DateRange, drs *DateRanges) {
+	if n == nil || n.maxEnd.Before(r.start) {
+		return
+	}
+
+	n.left.collectOverlapping(r, drs)
+
+	if n.r.start.After(r.end) {
+		return
+	}
+
+	if n.r.OverlapsWith(r) {
+		for i := 0; i < n.count; i++ {
+			*drs = append(*drs, n.r)
+		}
+	}
+
+	n.right.collectOverlapping(r, drs)
+}
+
+// collect appends all DateRange instances in the subtree in ascending order.
+func (n *dateRangeNode) collect(drs *DateRanges) {
+	if n == nil {
+		return
+	}
+
+	n.left.collect(drs)
+	for i := 0; i < n.count; i++ {
+		*drs = append(*drs, n.r)
+	}
+	n.right.collect(drs)
+}
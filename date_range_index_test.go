@@ -0,0 +1,254 @@
+package date
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testDateRangeIndexRanges() DateRanges {
+	return DateRanges{
+		MustParseDateRange("2024-06-10", "2024-06-20"),
+		MustParseDateRange("2024-06-01", "2024-06-05"),
+		MustParseDateRange("2024-06-03", "2024-06-12"),
+		MustParseDateRange("2024-06-01", "2024-06-05"),
+		MustParseDateRange("2024-06-25", "2024-06-30"),
+		MustParseDateRange("2024-05-01", "2024-07-31"),
+	}
+}
+
+// randomDateRanges generates n DateRanges deterministically, starting within a decade and lasting up to 30 days.
+func randomDateRanges(n int, seed int64) DateRanges {
+	rnd := rand.New(rand.NewSource(seed))
+	base := NewDate(2020, time.January, 1)
+	drs := make(DateRanges, n)
+
+	for i := range drs {
+		start := base.AddDays(rnd.Intn(3650))
+		drs[i] = DateRange{start, start.AddDays(rnd.Intn(30))}
+	}
+
+	return drs
+}
+
+// bruteForceOverlapping returns the DateRanges overlapping with r by scanning all of them.
+func bruteForceOverlapping(drs DateRanges, r DateRange) DateRanges {
+	found := make(DateRanges, 0)
+
+	for _, dr := range drs {
+		if dr.OverlapsWith(r) {
+			found = append(found, dr)
+		}
+	}
+
+	return found.SortMutable()
+}
+
+func TestDateRangeIndexStabbing(t *testing.T) {
+	tests := []struct {
+		date Date
+		want []string
+	}{
+		{MustParse("2024-04-30"), []string{}},
+		{MustParse("2024-06-01"), []string{"2024-05-01/2024-07-31", "2024-06-01/2024-06-05", "2024-06-01/2024-06-05"}},
+		{MustParse("2024-06-04"), []string{"2024-05-01/2024-07-31", "2024-06-01/2024-06-05", "2024-06-01/2024-06-05", "2024-06-03/2024-06-12"}},
+		{MustParse("2024-06-12"), []string{"2024-05-01/2024-07-31", "2024-06-03/2024-06-12", "2024-06-10/2024-06-20"}},
+		{MustParse("2024-06-22"), []string{"2024-05-01/2024-07-31"}},
+		{MustParse("2024-08-01"), []string{}},
+	}
+
+	ix := NewDateRangeIndex(testDateRangeIndexRanges())
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`DateRangeIndex.Stabbing(Date{"%s"})`, tt.date)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, ix.Stabbing(tt.date).Strings())
+		})
+	}
+}
+
+func TestDateRangeIndexOverlapping(t *testing.T) {
+	tests := []struct {
+		r    DateRange
+		want []string
+	}{
+		{MustParseDateRange("2024-04-01", "2024-04-30"), []string{}},
+		{MustParseDateRange("2024-04-01", "2024-05-01"), []string{"2024-05-01/2024-07-31"}},
+		{
+			MustParseDateRange("2024-06-05", "2024-06-10"),
+			[]string{
+				"2024-05-01/2024-07-31",
+				"2024-06-01/2024-06-05",
+				"2024-06-01/2024-06-05",
+				"2024-06-03/2024-06-12",
+				"2024-06-10/2024-06-20",
+			},
+		},
+		{
+			MustParseDateRange("2024-06-13", "2024-06-26"),
+			[]string{"2024-05-01/2024-07-31", "2024-06-10/2024-06-20", "2024-06-25/2024-06-30"},
+		},
+	}
+
+	ix := NewDateRangeIndex(testDateRangeIndexRanges())
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`DateRangeIndex.Overlapping(DateRange{"%s"})`, tt.r)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, ix.Overlapping(tt.r).Strings())
+		})
+	}
+}
+
+func TestDateRangeIndexInsert(t *testing.T) {
+	t.Run("DateRangeIndex{}.Insert()", func(t *testing.T) {
+		var ix DateRangeIndex
+
+		for _, r := range testDateRangeIndexRanges() {
+			ix.Insert(r)
+		}
+
+		assert.Equal(t, 6, ix.Len())
+		assert.Equal(t, testDateRangeIndexRanges().Sort().Strings(), ix.DateRanges().Strings())
+		assert.Equal(
+			t,
+			[]string{"2024-05-01/2024-07-31", "2024-06-01/2024-06-05", "2024-06-01/2024-06-05"},
+			ix.Stabbing(MustParse("2024-06-02")).Strings(),
+		)
+	})
+}
+
+func TestDateRangeIndexDelete(t *testing.T) {
+	tests := []struct {
+		r    DateRange
+		ok   bool
+		want []string
+	}{
+		{
+			MustParseDateRange("2024-06-01", "2024-06-05"),
+			true,
+			[]string{"2024-05-01/2024-07-31", "2024-06-01/2024-06-05", "2024-06-03/2024-06-12"},
+		},
+		{
+			MustParseDateRange("2024-05-01", "2024-07-31"),
+			true,
+			[]string{"2024-06-01/2024-06-05", "2024-06-01/2024-06-05", "2024-06-03/2024-06-12"},
+		},
+		{
+			MustParseDateRange("2024-06-01", "2024-06-04"),
+			false,
+			[]string{"2024-05-01/2024-07-31", "2024-06-01/2024-06-05", "2024-06-01/2024-06-05", "2024-06-03/2024-06-12"},
+		},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`DateRangeIndex.Delete(DateRange{"%s"})`, tt.r)
+
+		t.Run(testcase, func(t *testing.T) {
+			ix := NewDateRangeIndex(testDateRangeIndexRanges())
+
+			assert.Equal(t, tt.ok, ix.Delete(tt.r))
+			assert.Equal(t, tt.want, ix.Stabbing(MustParse("2024-06-04")).Strings())
+
+			if tt.ok {
+				assert.Equal(t, 5, ix.Len())
+			} else {
+				assert.Equal(t, 6, ix.Len())
+			}
+		})
+	}
+}
+
+func TestDateRangeIndexAgainstBruteForce(t *testing.T) {
+	drs := randomDateRanges(2000, 1)
+	queries := randomDateRanges(200, 2)
+
+	var ix DateRangeIndex
+	for _, r := range drs {
+		ix.Insert(r)
+	}
+
+	for i := 0; i < len(drs); i += 2 {
+		assert.True(t, ix.Delete(drs[i]))
+	}
+
+	remaining := make(DateRanges, 0, len(drs)/2)
+	for i := 1; i < len(drs); i += 2 {
+		remaining = append(remaining, drs[i])
+	}
+
+	assert.Equal(t, len(remaining), ix.Len())
+	assert.Equal(t, remaining.Sort().Strings(), ix.DateRanges().Strings())
+
+	built := NewDateRangeIndex(remaining)
+
+	for _, q := range queries {
+		testcase := fmt.Sprintf(`DateRangeIndex.Overlapping(DateRange{"%s"})`, q)
+
+		t.Run(testcase, func(t *testing.T) {
+			want := strings.Join(bruteForceOverlapping(remaining, q).Strings(), ",")
+
+			assert.Equal(t, want, strings.Join(ix.Overlapping(q).Strings(), ","))
+			assert.Equal(t, want, strings.Join(built.Overlapping(q).Strings(), ","))
+		})
+	}
+}
+
+func BenchmarkNewDateRangeIndex(b *testing.B) {
+	drs := randomDateRanges(200000, 1)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		NewDateRangeIndex(drs)
+	}
+}
+
+func BenchmarkDateRangeIndexInsert(b *testing.B) {
+	drs := randomDateRanges(200000, 1)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var ix DateRangeIndex
+		for _, r := range drs {
+			ix.Insert(r)
+		}
+	}
+}
+
+func BenchmarkDateRangeIndexStabbing(b *testing.B) {
+	ix := NewDateRangeIndex(randomDateRanges(200000, 1))
+	dates := randomDateRanges(1024, 2).StartDates()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ix.Stabbing(dates[i%len(dates)])
+	}
+}
+
+func BenchmarkDateRangeIndexOverlapping(b *testing.B) {
+	ix := NewDateRangeIndex(randomDateRanges(200000, 1))
+	queries := randomDateRanges(1024, 2)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ix.Overlapping(queries[i%len(queries)])
+	}
+}
+
+func BenchmarkDateRangeIndexInsertDelete(b *testing.B) {
+	ix := NewDateRangeIndex(randomDateRanges(200000, 1))
+	drs := randomDateRanges(1024, 2)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		r := drs[i%len(drs)]
+		ix.Insert(r)
+		ix.Delete(r)
+	}
+}
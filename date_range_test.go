@@ -1,6 +1,7 @@
 package date
 
 import (
+	"database/sql/driver"
 	"encoding/json"
 	"fmt"
 	"testing"
@@ -365,6 +366,67 @@ func TestMustCustomParseDateRange(t *testing.T) {
 	}
 }
 
+func TestParseInterval(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    string
+		wantErr error
+	}{
+		{"2024-03-01/2024-03-31", "2024-03-01/2024-03-31", nil},
+		{"2024-03-01/2024-03-01", "2024-03-01/2024-03-01", nil},
+		{"2024-03-01/P1M", "2024-03-01/2024-03-31", nil},
+		{"2024-01-31/P1M", "2024-01-31/2024-02-28", nil},
+		{"2024-03-01/P1D", "2024-03-01/2024-03-01", nil},
+		{"2024-03-01/P2W", "2024-03-01/2024-03-14", nil},
+		{"2024-03-01/P1Y", "2024-03-01/2025-02-28", nil},
+		{"P7D/2024-03-31", "2024-03-25/2024-03-31", nil},
+		{"P1M/2024-03-31", "2024-03-01/2024-03-31", nil},
+		{"2024-03-01/15", "2024-03-01/2024-03-15", nil},
+		{"2024-03-01/04-15", "2024-03-01/2024-04-15", nil},
+		{"2024-03-01", "", ErrInvalidInterval},
+		{"P1M/P1M", "", ErrInvalidInterval},
+		{"2024-03-01/P0D", "", ErrInvalidInterval},
+		{"2024-03-01/-P1D", "", nil},
+		{"2024-03-01/P-1D", "", ErrInvalidInterval},
+		{"2024-03-31/2024-03-01", "", ErrEndDateIsBeforeStartDate},
+		{"2024-03-15/01", "", ErrEndDateIsBeforeStartDate},
+		{"2024-03-01/P1X", "", ErrInvalidPeriod},
+		{"2024-03-01/2024-3-31", "", nil},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`ParseInterval("%s")`, tt.value)
+
+		t.Run(testcase, func(t *testing.T) {
+			r, err := ParseInterval(tt.value)
+
+			if tt.want == "" {
+				assert.Error(t, err)
+				if tt.wantErr != nil {
+					assert.ErrorIs(t, err, tt.wantErr)
+				}
+
+				return
+			}
+
+			assert.Nil(t, err, "Expected no error, got %v", err)
+			assert.Equal(t, tt.want, r.String())
+		})
+	}
+}
+
+func TestMustParseInterval(t *testing.T) {
+	t.Run(`MustParseInterval("2024-03-01/P1M")`, func(t *testing.T) {
+		assert.Equal(t, MustParseDateRange("2024-03-01", "2024-03-31"), MustParseInterval("2024-03-01/P1M"))
+	})
+
+	t.Run(`MustParseInterval("2024-03-01")`, func(t *testing.T) {
+		assert.Panics(t, func() {
+			MustParseInterval("2024-03-01")
+		})
+	})
+}
+
 // Determination methods
 // --------------------------------------------------
 
@@ -2072,6 +2134,168 @@ func TestDateRangeDates(t *testing.T) {
 	}
 }
 
+func TestDateRangeSplitByWeek(t *testing.T) {
+	tests := []struct {
+		dr           DateRange
+		startWeekday time.Weekday
+		want         []string
+	}{
+		{
+			MustParseDateRange("2024-06-05", "2024-06-20"),
+			time.Monday,
+			[]string{"2024-06-05/2024-06-09", "2024-06-10/2024-06-16", "2024-06-17/2024-06-20"},
+		},
+		{
+			MustParseDateRange("2024-06-05", "2024-06-20"),
+			time.Sunday,
+			[]string{"2024-06-05/2024-06-08", "2024-06-09/2024-06-15", "2024-06-16/2024-06-20"},
+		},
+		{
+			MustParseDateRange("2024-06-10", "2024-06-16"),
+			time.Monday,
+			[]string{"2024-06-10/2024-06-16"},
+		},
+		{
+			MustParseDateRange("2024-06-16", "2024-06-16"),
+			time.Monday,
+			[]string{"2024-06-16/2024-06-16"},
+		},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`DateRange{"%s"}.SplitByWeek(%s)`, tt.dr, tt.startWeekday)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.dr.SplitByWeek(tt.startWeekday).Strings())
+		})
+	}
+}
+
+func TestDateRangeSplitByMonth(t *testing.T) {
+	tests := []struct {
+		dr   DateRange
+		want []string
+	}{
+		{
+			MustParseDateRange("2024-01-15", "2024-03-10"),
+			[]string{"2024-01-15/2024-01-31", "2024-02-01/2024-02-29", "2024-03-01/2024-03-10"},
+		},
+		{
+			MustParseDateRange("2024-02-01", "2024-02-29"),
+			[]string{"2024-02-01/2024-02-29"},
+		},
+		{
+			MustParseDateRange("2024-12-31", "2025-01-01"),
+			[]string{"2024-12-31/2024-12-31", "2025-01-01/2025-01-01"},
+		},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`DateRange{"%s"}.SplitByMonth()`, tt.dr)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.dr.SplitByMonth().Strings())
+		})
+	}
+}
+
+func TestDateRangeSplitByQuarter(t *testing.T) {
+	tests := []struct {
+		dr   DateRange
+		want []string
+	}{
+		{
+			MustParseDateRange("2024-02-15", "2024-08-10"),
+			[]string{"2024-02-15/2024-03-31", "2024-04-01/2024-06-30", "2024-07-01/2024-08-10"},
+		},
+		{
+			MustParseDateRange("2024-10-01", "2024-12-31"),
+			[]string{"2024-10-01/2024-12-31"},
+		},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`DateRange{"%s"}.SplitByQuarter()`, tt.dr)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.dr.SplitByQuarter().Strings())
+		})
+	}
+}
+
+func TestDateRangeSplitByYear(t *testing.T) {
+	tests := []struct {
+		dr   DateRange
+		want []string
+	}{
+		{
+			MustParseDateRange("2023-07-01", "2025-03-31"),
+			[]string{"2023-07-01/2023-12-31", "2024-01-01/2024-12-31", "2025-01-01/2025-03-31"},
+		},
+		{
+			MustParseDateRange("2024-03-01", "2024-03-31"),
+			[]string{"2024-03-01/2024-03-31"},
+		},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`DateRange{"%s"}.SplitByYear()`, tt.dr)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.dr.SplitByYear().Strings())
+		})
+	}
+}
+
+func TestDateRangeSplitEvery(t *testing.T) {
+	tests := []struct {
+		dr   DateRange
+		n    int
+		want []string
+	}{
+		{
+			MustParseDateRange("2024-06-01", "2024-06-10"),
+			4,
+			[]string{"2024-06-01/2024-06-04", "2024-06-05/2024-06-08", "2024-06-09/2024-06-10"},
+		},
+		{
+			MustParseDateRange("2024-06-01", "2024-06-03"),
+			1,
+			[]string{"2024-06-01/2024-06-01", "2024-06-02/2024-06-02", "2024-06-03/2024-06-03"},
+		},
+		{
+			MustParseDateRange("2024-06-01", "2024-06-03"),
+			7,
+			[]string{"2024-06-01/2024-06-03"},
+		},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`DateRange{"%s"}.SplitEvery(%d)`, tt.dr, tt.n)
+
+		t.Run(testcase, func(t *testing.T) {
+			drs, err := tt.dr.SplitEvery(tt.n)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, drs.Strings())
+			assert.Equal(t, tt.want, tt.dr.MustSplitEvery(tt.n).Strings(), "MustSplitEvery")
+		})
+	}
+
+	for _, n := range []int{0, -1} {
+		testcase := fmt.Sprintf(`DateRange{"2024-06-01/2024-06-03"}.SplitEvery(%d)`, n)
+
+		t.Run(testcase, func(t *testing.T) {
+			_, err := MustParseDateRange("2024-06-01", "2024-06-03").SplitEvery(n)
+
+			assert.ErrorIs(t, err, ErrInvalidSplitSize)
+			assert.Panics(t, func() {
+				MustParseDateRange("2024-06-01", "2024-06-03").MustSplitEvery(n)
+			})
+		})
+	}
+}
+
 func TestDateRangeString(t *testing.T) {
 	tests := []struct {
 		dr   DateRange
@@ -2142,6 +2366,119 @@ func TestDateRangeMarshalJSON(t *testing.T) {
 	}
 }
 
+func TestDateRangeValue(t *testing.T) {
+	tests := []struct {
+		dr        DateRange
+		wantValue driver.Value
+	}{
+		{MustParseDateRange("2024-03-01", "2024-03-31"), "[2024-03-01,2024-04-01)"},
+		{MustParseDateRange("2024-03-01", "2024-03-01"), "[2024-03-01,2024-03-02)"},
+		{ZeroDateRange(), "empty"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`DateRange{"%s"}.Value()`, tt.dr)
+
+		t.Run(testcase, func(t *testing.T) {
+			value, err := tt.dr.Value()
+			assert.Nil(t, err, "Expected no error, got %v", err)
+			assert.Equal(t, tt.wantValue, value)
+		})
+	}
+}
+
+func TestDateRangeScan(t *testing.T) {
+	tests := []struct {
+		value   interface{}
+		want    string
+		wantErr error
+	}{
+		{"[2024-03-01,2024-04-01)", "2024-03-01/2024-03-31", nil},
+		{"[2024-03-01,2024-03-31]", "2024-03-01/2024-03-31", nil},
+		{"(2024-02-29,2024-04-01)", "2024-03-01/2024-03-31", nil},
+		{"(2024-02-29,2024-03-31]", "2024-03-01/2024-03-31", nil},
+		{[]byte("[2024-03-01,2024-04-01)"), "2024-03-01/2024-03-31", nil},
+		{`["2024-03-01","2024-04-01")`, "2024-03-01/2024-03-31", nil},
+		{"empty", "0001-01-01/0001-01-01", nil},
+		{"[2024-03-01,2024-03-01)", "0001-01-01/0001-01-01", nil},
+		{nil, "0001-01-01/0001-01-01", nil},
+		{"[2024-03-01,)", "", ErrDateRangeIsUnbounded},
+		{"[2024-03-01,infinity)", "", ErrDateRangeIsUnbounded},
+		{"(,2024-04-01)", "", ErrDateRangeIsUnbounded},
+		{"2024-03-01/2024-03-31", "", ErrInvalidRangeLiteral},
+		{20240301, "", ErrInvalidRangeLiteral},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`DateRange{}.Scan(%#v)`, tt.value)
+
+		t.Run(testcase, func(t *testing.T) {
+			var dr DateRange
+			err := dr.Scan(tt.value)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.True(t, dr.IsZero(), "date range is not zero")
+
+				return
+			}
+
+			assert.Nil(t, err, "Expected no error, got %v", err)
+			assert.Equal(t, tt.want, dr.String())
+		})
+	}
+}
+
+func TestDateRangeMarshalText(t *testing.T) {
+	tests := []struct {
+		dr   DateRange
+		want string
+	}{
+		{MustParseDateRange("2024-03-01", "2024-03-31"), "2024-03-01/2024-03-31"},
+		{ZeroDateRange(), "0001-01-01/0001-01-01"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`DateRange{"%s"}.MarshalText()`, tt.dr)
+
+		t.Run(testcase, func(t *testing.T) {
+			text, err := tt.dr.MarshalText()
+			assert.Nil(t, err, "Expected no error, got %v", err)
+			assert.Equal(t, tt.want, string(text))
+		})
+	}
+}
+
+func TestDateRangeUnmarshalText(t *testing.T) {
+	tests := []struct {
+		text    string
+		want    DateRange
+		wantErr bool
+	}{
+		{"2024-03-01/2024-03-31", MustParseDateRange("2024-03-01", "2024-03-31"), false},
+		{"2024-03-01/P1M", MustParseDateRange("2024-03-01", "2024-03-31"), false},
+		{"0001-01-01/0001-01-01", ZeroDateRange(), false},
+		{"2024-03-01", ZeroDateRange(), true},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`DateRange.UnmarshalText("%s")`, tt.text)
+
+		t.Run(testcase, func(t *testing.T) {
+			var dr DateRange
+			err := dr.UnmarshalText([]byte(tt.text))
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidInterval)
+			} else {
+				assert.Nil(t, err, "Expected no error, got %v", err)
+			}
+
+			assert.True(t, tt.want.Equal(dr), "Expected %v, got %v", tt.want, dr)
+		})
+	}
+}
+
 func TestDateRangeUnmarshalJSON(t *testing.T) {
 	tests := []struct {
 		json    string
@@ -120,6 +120,126 @@ func (drs DateRanges) Strings() []string {
 	return ranges
 }
 
+// Contains checks if any DateRange instance in the DateRanges slice contains the specified date.
+func (drs DateRanges) Contains(date Date) bool {
+	for _, r := range drs {
+		if r.Contains(date) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Merge returns a new DateRanges slice in ascending order,
+// in which overlapping and adjacent DateRange instances are coalesced into one.
+func (drs DateRanges) Merge() DateRanges {
+	merged := make(DateRanges, 0, len(drs))
+
+	for _, r := range drs.Sort() {
+		last := len(merged) - 1
+		if last >= 0 && r.start.BeforeOrEqual(merged[last].end.AddDay()) {
+			if r.end.After(merged[last].end) {
+				merged[last].end = r.end
+			}
+
+			continue
+		}
+
+		merged = append(merged, r)
+	}
+
+	return merged
+}
+
+// Union returns the merged DateRanges covering the dates in either the DateRanges slice or the other.
+func (drs DateRanges) Union(other DateRanges) DateRanges {
+	return append(drs.clone(), other...).Merge()
+}
+
+// Intersect returns the merged DateRanges covering the dates in both the DateRanges slice and the other.
+func (drs DateRanges) Intersect(other DateRanges) DateRanges {
+	a, b := drs.Merge(), other.Merge()
+	intersection := make(DateRanges, 0)
+
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		if overlap, err := a[i].GetOverlapping(b[j]); err == nil {
+			intersection = append(intersection, overlap)
+		}
+
+		if a[i].end.Before(b[j].end) {
+			i++
+		} else {
+			j++
+		}
+	}
+
+	return intersection
+}
+
+// Subtract returns the merged DateRanges covering the dates in the DateRanges slice but not in the other.
+func (drs DateRanges) Subtract(other DateRanges) DateRanges {
+	b := other.Merge()
+	difference := make(DateRanges, 0)
+
+	for _, r := range drs.Merge() {
+		remaining := true
+
+		for _, s := range b {
+			if !r.OverlapsWith(s) {
+				continue
+			}
+
+			if s.start.After(r.start) {
+				difference = append(difference, DateRange{r.start, s.start.SubDay()})
+			}
+			if s.end.AfterOrEqual(r.end) {
+				remaining = false
+
+				break
+			}
+
+			r.start = s.end.AddDay()
+		}
+
+		if remaining {
+			difference = append(difference, r)
+		}
+	}
+
+	return difference
+}
+
+// Gaps returns the DateRanges between the DateRange instances in the DateRanges slice,
+// which are the dates from the first start to the last end not covered by any of them.
+func (drs DateRanges) Gaps() DateRanges {
+	merged := drs.Merge()
+	gaps := make(DateRanges, 0, max(len(merged)-1, 0))
+
+	for i := 1; i < len(merged); i++ {
+		gaps = append(gaps, DateRange{merged[i-1].end.AddDay(), merged[i].start.SubDay()})
+	}
+
+	return gaps
+}
+
+// ComplementWithin returns the DateRanges within the bounds not covered by any DateRange instance in the DateRanges slice.
+func (drs DateRanges) ComplementWithin(bounds DateRange) DateRanges {
+	return DateRanges{bounds}.Subtract(drs)
+}
+
+// Coverage returns the number of days within the bounds covered by the DateRanges slice.
+// Days covered by more than one DateRange instance are counted once.
+func (drs DateRanges) Coverage(bounds DateRange) int {
+	days := 0
+
+	for _, r := range drs.Intersect(DateRanges{bounds}) {
+		days += daysBetween(r.start, r.end) + 1
+	}
+
+	return days
+}
+
 // clone creates a copy of the DateRanges slice.
 func (drs DateRanges) clone() DateRanges {
 	ranges := make(DateRanges, len(drs))
@@ -500,3 +500,330 @@ func TestDateRangesStrings(t *testing.T) {
 		})
 	}
 }
+
+func TestDateRangesContains(t *testing.T) {
+	ranges := DateRanges{
+		MustParseDateRange("2024-06-01", "2024-06-03"),
+		MustParseDateRange("2024-06-10", "2024-06-10"),
+	}
+
+	tests := []struct {
+		date Date
+		want bool
+	}{
+		{MustParse("2024-06-01"), true},
+		{MustParse("2024-06-03"), true},
+		{MustParse("2024-06-04"), false},
+		{MustParse("2024-06-10"), true},
+		{MustParse("2024-06-11"), false},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`DateRanges{"%s"}.Contains(Date{"%s"})`, strings.Join(ranges.Strings(), `","`), tt.date)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, ranges.Contains(tt.date))
+		})
+	}
+
+	t.Run(`DateRanges{}.Contains(Date{"2024-06-01"})`, func(t *testing.T) {
+		assert.False(t, DateRanges{}.Contains(MustParse("2024-06-01")))
+	})
+}
+
+func TestDateRangesMerge(t *testing.T) {
+	tests := []struct {
+		ranges DateRanges
+		want   []string
+	}{
+		{
+			DateRanges{},
+			[]string{},
+		},
+		{
+			DateRanges{
+				MustParseDateRange("2024-06-10", "2024-06-12"),
+				MustParseDateRange("2024-06-01", "2024-06-03"),
+			},
+			[]string{"2024-06-01/2024-06-03", "2024-06-10/2024-06-12"},
+		},
+		{
+			DateRanges{
+				MustParseDateRange("2024-06-01", "2024-06-05"),
+				MustParseDateRange("2024-06-03", "2024-06-08"),
+				MustParseDateRange("2024-06-09", "2024-06-10"),
+				MustParseDateRange("2024-06-12", "2024-06-12"),
+			},
+			[]string{"2024-06-01/2024-06-10", "2024-06-12/2024-06-12"},
+		},
+		{
+			DateRanges{
+				MustParseDateRange("2024-06-01", "2024-06-30"),
+				MustParseDateRange("2024-06-05", "2024-06-06"),
+				MustParseDateRange("2024-06-01", "2024-06-30"),
+			},
+			[]string{"2024-06-01/2024-06-30"},
+		},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`DateRanges{"%s"}.Merge()`, strings.Join(tt.ranges.Strings(), `","`))
+
+		t.Run(testcase, func(t *testing.T) {
+			original := tt.ranges.clone()
+
+			assert.Equal(t, tt.want, tt.ranges.Merge().Strings())
+			assert.Equal(t, original, tt.ranges, "receiver was modified")
+		})
+	}
+}
+
+func TestDateRangesUnion(t *testing.T) {
+	tests := []struct {
+		ranges DateRanges
+		other  DateRanges
+		want   []string
+	}{
+		{
+			DateRanges{MustParseDateRange("2024-06-01", "2024-06-03")},
+			DateRanges{MustParseDateRange("2024-06-04", "2024-06-05")},
+			[]string{"2024-06-01/2024-06-05"},
+		},
+		{
+			DateRanges{MustParseDateRange("2024-06-10", "2024-06-12")},
+			DateRanges{MustParseDateRange("2024-06-01", "2024-06-03"), MustParseDateRange("2024-06-11", "2024-06-20")},
+			[]string{"2024-06-01/2024-06-03", "2024-06-10/2024-06-20"},
+		},
+		{
+			DateRanges{},
+			DateRanges{MustParseDateRange("2024-06-01", "2024-06-03")},
+			[]string{"2024-06-01/2024-06-03"},
+		},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(
+			`DateRanges{"%s"}.Union(DateRanges{"%s"})`,
+			strings.Join(tt.ranges.Strings(), `","`),
+			strings.Join(tt.other.Strings(), `","`),
+		)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.ranges.Union(tt.other).Strings())
+		})
+	}
+}
+
+func TestDateRangesIntersect(t *testing.T) {
+	tests := []struct {
+		ranges DateRanges
+		other  DateRanges
+		want   []string
+	}{
+		{
+			DateRanges{MustParseDateRange("2024-06-01", "2024-06-10")},
+			DateRanges{MustParseDateRange("2024-06-05", "2024-06-15")},
+			[]string{"2024-06-05/2024-06-10"},
+		},
+		{
+			DateRanges{MustParseDateRange("2024-06-01", "2024-06-30")},
+			DateRanges{MustParseDateRange("2024-06-03", "2024-06-04"), MustParseDateRange("2024-06-20", "2024-07-05")},
+			[]string{"2024-06-03/2024-06-04", "2024-06-20/2024-06-30"},
+		},
+		{
+			DateRanges{MustParseDateRange("2024-06-01", "2024-06-05"), MustParseDateRange("2024-06-08", "2024-06-12")},
+			DateRanges{MustParseDateRange("2024-06-04", "2024-06-09")},
+			[]string{"2024-06-04/2024-06-05", "2024-06-08/2024-06-09"},
+		},
+		{
+			DateRanges{MustParseDateRange("2024-06-01", "2024-06-05")},
+			DateRanges{MustParseDateRange("2024-06-06", "2024-06-09")},
+			[]string{},
+		},
+		{
+			DateRanges{MustParseDateRange("2024-06-01", "2024-06-05")},
+			DateRanges{},
+			[]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(
+			`DateRanges{"%s"}.Intersect(DateRanges{"%s"})`,
+			strings.Join(tt.ranges.Strings(), `","`),
+			strings.Join(tt.other.Strings(), `","`),
+		)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.ranges.Intersect(tt.other).Strings())
+			assert.Equal(t, tt.want, tt.other.Intersect(tt.ranges).Strings(), "commutative")
+		})
+	}
+}
+
+func TestDateRangesSubtract(t *testing.T) {
+	tests := []struct {
+		ranges DateRanges
+		other  DateRanges
+		want   []string
+	}{
+		{
+			DateRanges{MustParseDateRange("2024-06-01", "2024-06-30")},
+			DateRanges{MustParseDateRange("2024-06-10", "2024-06-12")},
+			[]string{"2024-06-01/2024-06-09", "2024-06-13/2024-06-30"},
+		},
+		{
+			DateRanges{MustParseDateRange("2024-06-01", "2024-06-30")},
+			DateRanges{
+				MustParseDateRange("2024-05-20", "2024-06-02"),
+				MustParseDateRange("2024-06-15", "2024-06-15"),
+				MustParseDateRange("2024-06-28", "2024-07-10"),
+			},
+			[]string{"2024-06-03/2024-06-14", "2024-06-16/2024-06-27"},
+		},
+		{
+			DateRanges{MustParseDateRange("2024-06-01", "2024-06-05"), MustParseDateRange("2024-06-20", "2024-06-25")},
+			DateRanges{MustParseDateRange("2024-06-04", "2024-06-21")},
+			[]string{"2024-06-01/2024-06-03", "2024-06-22/2024-06-25"},
+		},
+		{
+			DateRanges{MustParseDateRange("2024-06-01", "2024-06-05")},
+			DateRanges{MustParseDateRange("2024-06-01", "2024-06-05")},
+			[]string{},
+		},
+		{
+			DateRanges{MustParseDateRange("2024-06-01", "2024-06-05")},
+			DateRanges{},
+			[]string{"2024-06-01/2024-06-05"},
+		},
+		{
+			DateRanges{},
+			DateRanges{MustParseDateRange("2024-06-01", "2024-06-05")},
+			[]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(
+			`DateRanges{"%s"}.Subtract(DateRanges{"%s"})`,
+			strings.Join(tt.ranges.Strings(), `","`),
+			strings.Join(tt.other.Strings(), `","`),
+		)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.ranges.Subtract(tt.other).Strings())
+		})
+	}
+}
+
+func TestDateRangesGaps(t *testing.T) {
+	tests := []struct {
+		ranges DateRanges
+		want   []string
+	}{
+		{
+			DateRanges{},
+			[]string{},
+		},
+		{
+			DateRanges{MustParseDateRange("2024-06-01", "2024-06-30")},
+			[]string{},
+		},
+		{
+			DateRanges{
+				MustParseDateRange("2024-06-20", "2024-06-30"),
+				MustParseDateRange("2024-06-01", "2024-06-05"),
+				MustParseDateRange("2024-06-04", "2024-06-10"),
+				MustParseDateRange("2024-06-11", "2024-06-14"),
+				MustParseDateRange("2024-06-16", "2024-06-16"),
+			},
+			[]string{"2024-06-15/2024-06-15", "2024-06-17/2024-06-19"},
+		},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`DateRanges{"%s"}.Gaps()`, strings.Join(tt.ranges.Strings(), `","`))
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.ranges.Gaps().Strings())
+		})
+	}
+}
+
+func TestDateRangesComplementWithin(t *testing.T) {
+	tests := []struct {
+		ranges DateRanges
+		bounds DateRange
+		want   []string
+	}{
+		{
+			DateRanges{},
+			MustParseDateRange("2024-06-01", "2024-06-30"),
+			[]string{"2024-06-01/2024-06-30"},
+		},
+		{
+			DateRanges{
+				MustParseDateRange("2024-05-25", "2024-06-03"),
+				MustParseDateRange("2024-06-10", "2024-06-12"),
+				MustParseDateRange("2024-07-01", "2024-07-05"),
+			},
+			MustParseDateRange("2024-06-01", "2024-06-30"),
+			[]string{"2024-06-04/2024-06-09", "2024-06-13/2024-06-30"},
+		},
+		{
+			DateRanges{MustParseDateRange("2024-05-01", "2024-07-31")},
+			MustParseDateRange("2024-06-01", "2024-06-30"),
+			[]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`DateRanges{"%s"}.ComplementWithin(DateRange{"%s"})`, strings.Join(tt.ranges.Strings(), `","`), tt.bounds)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.ranges.ComplementWithin(tt.bounds).Strings())
+		})
+	}
+}
+
+func TestDateRangesCoverage(t *testing.T) {
+	tests := []struct {
+		ranges DateRanges
+		bounds DateRange
+		want   int
+	}{
+		{
+			DateRanges{},
+			MustParseDateRange("2024-06-01", "2024-06-30"),
+			0,
+		},
+		{
+			DateRanges{
+				MustParseDateRange("2024-05-25", "2024-06-03"),
+				MustParseDateRange("2024-06-02", "2024-06-05"),
+				MustParseDateRange("2024-06-10", "2024-06-12"),
+				MustParseDateRange("2024-06-29", "2024-07-05"),
+			},
+			MustParseDateRange("2024-06-01", "2024-06-30"),
+			10,
+		},
+		{
+			DateRanges{MustParseDateRange("2024-05-01", "2024-07-31")},
+			MustParseDateRange("2024-06-01", "2024-06-30"),
+			30,
+		},
+		{
+			DateRanges{MustParseDateRange("2000-01-01", "9999-12-31")},
+			MustParseDateRange("1900-01-01", "9999-12-31"),
+			2921940,
+		},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`DateRanges{"%s"}.Coverage(DateRange{"%s"})`, strings.Join(tt.ranges.Strings(), `","`), tt.bounds)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.ranges.Coverage(tt.bounds))
+		})
+	}
+}
@@ -124,6 +124,17 @@ func (ds Dates) Strings() []string {
 	return dates
 }
 
+// contains checks if the Dates slice contains the specified date.
+func (ds Dates) contains(date Date) bool {
+	for _, d := range ds {
+		if d.Equal(date) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // clone creates a copy of the Dates slice.
 func (ds Dates) clone() Dates {
 	dates := make(Dates, len(ds))
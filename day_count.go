@@ -0,0 +1,151 @@
+package date
+
+import (
+	"time"
+)
+
+// DayCountConvention is a financial convention which determines the fraction of a year between two dates,
+// used to calculate accrued interest.
+type DayCountConvention int
+
+const (
+	// Actual360 divides the actual number of days by 360.
+	Actual360 DayCountConvention = iota
+	// Actual365Fixed divides the actual number of days by 365.
+	Actual365Fixed
+	// ActualActualISDA divides the days in leap years by 366 and the days in common years by 365.
+	ActualActualISDA
+	// Thirty360US counts 30 days per month following the US (NASD) rules,
+	// including the end-of-February adjustments.
+	Thirty360US
+	// ThirtyE360 counts 30 days per month, replacing the 31st of a month with the 30th. It is also known as Eurobond Basis.
+	ThirtyE360
+	// ThirtyE360ISDA counts 30 days per month, replacing the last day of a month with the 30th,
+	// except for the last day of February when it is the maturity date.
+	ThirtyE360ISDA
+)
+
+// Conversion methods
+// --------------------------------------------------
+
+// DayCount returns the number of days from start to end according to the convention.
+// It is negative if end is before start.
+func (c DayCountConvention) DayCount(start, end Date) int {
+	return c.dayCount(start, end, false)
+}
+
+// YearFraction returns the fraction of a year from start to end according to the convention.
+// It is negative if end is before start.
+// For ThirtyE360ISDA, end is not treated as the maturity date; use YearFractionWithMaturity if it may be.
+func (c DayCountConvention) YearFraction(start, end Date) float64 {
+	return c.YearFractionWithMaturity(start, end, ZeroDate())
+}
+
+// YearFractionWithMaturity returns the fraction of a year from start to end according to the convention,
+// where maturity is the final date of the instrument.
+// Only ThirtyE360ISDA depends on the maturity date; the other conventions return the same value as YearFraction.
+func (c DayCountConvention) YearFractionWithMaturity(start, end, maturity Date) float64 {
+	if end.Before(start) {
+		return c.YearFractionWithMaturity(end, start, maturity) * -1
+	}
+
+	switch c {
+	case Actual365Fixed:
+		return float64(daysBetween(start, end)) / 365
+	case ActualActualISDA:
+		return actualActualISDA(start, end)
+	case Actual360:
+		return float64(daysBetween(start, end)) / 360
+	}
+
+	return float64(c.dayCount(start, end, end.Equal(maturity))) / 360
+}
+
+// String returns the common name of the convention, such as "ACT/360".
+func (c DayCountConvention) String() string {
+	switch c {
+	case Actual360:
+		return "ACT/360"
+	case Actual365Fixed:
+		return "ACT/365F"
+	case ActualActualISDA:
+		return "ACT/ACT ISDA"
+	case Thirty360US:
+		return "30/360 US"
+	case ThirtyE360:
+		return "30E/360"
+	case ThirtyE360ISDA:
+		return "30E/360 ISDA"
+	}
+
+	return "unknown"
+}
+
+// dayCount returns the number of days from start to end, where terminal reports whether end is the maturity date.
+func (c DayCountConvention) dayCount(start, end Date, terminal bool) int {
+	if end.Before(start) {
+		return c.dayCount(end, start, terminal) * -1
+	}
+
+	y1, m1, d1 := start.Split()
+	y2, m2, d2 := end.Split()
+
+	switch c {
+	case Thirty360US:
+		if isLastOfFebruary(start) {
+			if isLastOfFebruary(end) {
+				d2 = 30
+			}
+			d1 = 30
+		}
+		if d2 == 31 && d1 >= 30 {
+			d2 = 30
+		}
+		if d1 == 31 {
+			d1 = 30
+		}
+	case ThirtyE360:
+		d1 = min(d1, 30)
+		d2 = min(d2, 30)
+	case ThirtyE360ISDA:
+		if start.IsLastOfMonth() {
+			d1 = 30
+		}
+		if end.IsLastOfMonth() && !(terminal && end.Month() == time.February) {
+			d2 = 30
+		}
+	default:
+		return daysBetween(start, end)
+	}
+
+	return 360*(y2-y1) + 30*(int(m2)-int(m1)) + d2 - d1
+}
+
+// actualActualISDA returns the ACT/ACT ISDA year fraction from start to end, where start is not after end.
+func actualActualISDA(start, end Date) float64 {
+	fraction := 0.0
+
+	for year := start.Year(); year <= end.Year(); year++ {
+		y := NewYear(year)
+		from := Dates{start, y.FirstDate()}.MustMax()
+		to := Dates{end, y.AddYear().FirstDate()}.MustMin()
+
+		fraction += float64(daysBetween(from, to)) / float64(y.Days())
+	}
+
+	return fraction
+}
+
+// isLastOfFebruary checks if the date is the last day of February.
+func isLastOfFebruary(date Date) bool {
+	return date.Month() == time.February && date.IsLastOfMonth()
+}
+
+// Conversion methods of DateRange
+// --------------------------------------------------
+
+// YearFraction returns the fraction of a year covered by the DateRange instance according to the convention.
+// As the end date of a DateRange is inclusive, the fraction is calculated up to the day after it.
+func (r DateRange) YearFraction(convention DayCountConvention) float64 {
+	return convention.YearFraction(r.start, r.end.AddDay())
+}
@@ -0,0 +1,139 @@
+package date
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDayCountConventionDayCount(t *testing.T) {
+	tests := []struct {
+		convention DayCountConvention
+		start, end Date
+		want       int
+	}{
+		{Actual360, MustParse("2024-01-15"), MustParse("2024-07-15"), 182},
+		{Actual365Fixed, MustParse("2024-01-15"), MustParse("2024-07-15"), 182},
+		{ActualActualISDA, MustParse("2024-01-15"), MustParse("2024-07-15"), 182},
+		{Thirty360US, MustParse("2024-01-15"), MustParse("2024-07-15"), 180},
+		{Thirty360US, MustParse("2024-02-29"), MustParse("2024-08-31"), 180},
+		{Thirty360US, MustParse("2024-08-31"), MustParse("2025-02-28"), 178},
+		{Thirty360US, MustParse("2023-02-28"), MustParse("2024-02-29"), 360},
+		{Thirty360US, MustParse("2024-01-30"), MustParse("2024-03-31"), 60},
+		{Thirty360US, MustParse("2024-01-29"), MustParse("2024-03-31"), 62},
+		{ThirtyE360, MustParse("2024-02-29"), MustParse("2024-08-31"), 181},
+		{ThirtyE360, MustParse("2024-08-31"), MustParse("2025-02-28"), 178},
+		{ThirtyE360, MustParse("2024-01-29"), MustParse("2024-03-31"), 61},
+		{ThirtyE360ISDA, MustParse("2024-02-29"), MustParse("2024-08-31"), 180},
+		{ThirtyE360ISDA, MustParse("2024-08-31"), MustParse("2025-02-28"), 180},
+		{Actual360, MustParse("2024-07-15"), MustParse("2024-01-15"), -182},
+		{Thirty360US, MustParse("2024-07-15"), MustParse("2024-01-15"), -180},
+		{Actual360, MustParse("1700-01-01"), MustParse("2024-01-01"), 118338},
+		{ActualActualISDA, MustParse("2024-01-01"), MustParse("1700-01-01"), -118338},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`%s.DayCount(Date{"%s"}, Date{"%s"})`, tt.convention, tt.start, tt.end)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.convention.DayCount(tt.start, tt.end))
+		})
+	}
+}
+
+func TestDayCountConventionYearFraction(t *testing.T) {
+	tests := []struct {
+		convention DayCountConvention
+		start, end Date
+		want       float64
+	}{
+		{Actual360, MustParse("2024-01-15"), MustParse("2024-07-15"), 182.0 / 360},
+		{Actual365Fixed, MustParse("2024-01-15"), MustParse("2024-07-15"), 182.0 / 365},
+		{ActualActualISDA, MustParse("2024-01-15"), MustParse("2024-07-15"), 182.0 / 366},
+		{ActualActualISDA, MustParse("2023-11-01"), MustParse("2024-03-01"), 61.0/365 + 60.0/366},
+		{ActualActualISDA, MustParse("2023-01-01"), MustParse("2025-01-01"), 2},
+		{Thirty360US, MustParse("2024-01-15"), MustParse("2024-07-15"), 0.5},
+		{ThirtyE360, MustParse("2024-02-29"), MustParse("2024-08-31"), 181.0 / 360},
+		{ThirtyE360ISDA, MustParse("2024-08-31"), MustParse("2025-02-28"), 0.5},
+		{Actual365Fixed, MustParse("2024-07-15"), MustParse("2024-01-15"), -182.0 / 365},
+		{ActualActualISDA, MustParse("2024-03-01"), MustParse("2023-11-01"), -(61.0/365 + 60.0/366)},
+		{Actual360, MustParse("2024-01-15"), MustParse("2024-01-15"), 0},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`%s.YearFraction(Date{"%s"}, Date{"%s"})`, tt.convention, tt.start, tt.end)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.InDelta(t, tt.want, tt.convention.YearFraction(tt.start, tt.end), 1e-12)
+		})
+	}
+}
+
+func TestDayCountConventionYearFractionWithMaturity(t *testing.T) {
+	tests := []struct {
+		convention           DayCountConvention
+		start, end, maturity Date
+		want                 float64
+	}{
+		{ThirtyE360ISDA, MustParse("2024-08-31"), MustParse("2025-02-28"), MustParse("2025-02-28"), 178.0 / 360},
+		{ThirtyE360ISDA, MustParse("2024-08-31"), MustParse("2025-02-28"), MustParse("2026-02-28"), 0.5},
+		{ThirtyE360ISDA, MustParse("2024-06-30"), MustParse("2024-12-31"), MustParse("2024-12-31"), 0.5},
+		{Thirty360US, MustParse("2024-08-31"), MustParse("2025-02-28"), MustParse("2025-02-28"), 178.0 / 360},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(
+			`%s.YearFractionWithMaturity(Date{"%s"}, Date{"%s"}, Date{"%s"})`,
+			tt.convention, tt.start, tt.end, tt.maturity,
+		)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.InDelta(t, tt.want, tt.convention.YearFractionWithMaturity(tt.start, tt.end, tt.maturity), 1e-12)
+		})
+	}
+}
+
+func TestDayCountConventionString(t *testing.T) {
+	tests := []struct {
+		convention DayCountConvention
+		want       string
+	}{
+		{Actual360, "ACT/360"},
+		{Actual365Fixed, "ACT/365F"},
+		{ActualActualISDA, "ACT/ACT ISDA"},
+		{Thirty360US, "30/360 US"},
+		{ThirtyE360, "30E/360"},
+		{ThirtyE360ISDA, "30E/360 ISDA"},
+		{DayCountConvention(-1), "unknown"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf("DayCountConvention(%d).String()", tt.convention)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.convention.String())
+		})
+	}
+}
+
+func TestDateRangeYearFraction(t *testing.T) {
+	tests := []struct {
+		r          DateRange
+		convention DayCountConvention
+		want       float64
+	}{
+		{MustNewDateRange(MustParse("2024-01-01"), MustParse("2024-12-31")), ActualActualISDA, 1},
+		{MustNewDateRange(MustParse("2024-01-01"), MustParse("2024-12-31")), Actual365Fixed, 366.0 / 365},
+		{MustNewDateRange(MustParse("2024-01-01"), MustParse("2024-06-30")), Thirty360US, 0.5},
+		{MustNewDateRange(MustParse("2024-01-15"), MustParse("2024-01-15")), Actual360, 1.0 / 360},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`DateRange{"%s"}.YearFraction(%s)`, tt.r, tt.convention)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.InDelta(t, tt.want, tt.r.YearFraction(tt.convention), 1e-12)
+		})
+	}
+}
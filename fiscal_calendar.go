@@ -0,0 +1,322 @@
+package date
+
+import (
+	"fmt"
+	"time"
+)
+
+// RetailPattern is the number of weeks in each of the three periods of a quarter in a retail calendar.
+type RetailPattern int
+
+const (
+	// Pattern445 has periods of 4, 4 and 5 weeks.
+	Pattern445 RetailPattern = iota
+	// Pattern454 has periods of 4, 5 and 4 weeks.
+	Pattern454
+	// Pattern544 has periods of 5, 4 and 4 weeks.
+	Pattern544
+)
+
+// weeks returns the number of weeks in each period of a quarter.
+func (p RetailPattern) weeks() [3]int {
+	switch p {
+	case Pattern454:
+		return [3]int{4, 5, 4}
+	case Pattern544:
+		return [3]int{5, 4, 4}
+	}
+
+	return [3]int{4, 4, 5}
+}
+
+// String returns the string representation of the RetailPattern, such as "4-4-5".
+func (p RetailPattern) String() string {
+	w := p.weeks()
+
+	return fmt.Sprintf("%d-%d-%d", w[0], w[1], w[2])
+}
+
+// RetailYearEnd specifies how the last day of a 52-53 week fiscal year is chosen.
+type RetailYearEnd int
+
+const (
+	// RetailYearEndLast ends the fiscal year on the last occurrence of the weekday in the end month.
+	RetailYearEndLast RetailYearEnd = iota
+	// RetailYearEndNearest ends the fiscal year on the occurrence of the weekday nearest to the last day of the end month,
+	// as in the NRF 4-5-4 calendar.
+	RetailYearEndNearest
+)
+
+var (
+	ErrFiscalCalendarIsRetail = fmt.Errorf("fiscal calendar is retail")
+)
+
+// FiscalCalendar maps dates to fiscal years, quarters and periods.
+// A fiscal year is labelled by the calendar year in which it starts, in the same way as FiscalQuarter.
+//
+// A month-based calendar has twelve periods which are the calendar months starting from its start month.
+// A retail calendar has fiscal years of 52 or 53 weeks ending on a fixed weekday,
+// divided into four quarters of 13 weeks following its RetailPattern.
+// The extra week of a 53-week year is added to the last period.
+// The zero value is a month-based calendar whose fiscal years start in January.
+type FiscalCalendar struct {
+	start      int // start month -1
+	retail     bool
+	pattern    RetailPattern
+	endWeekday time.Weekday
+	yearEnd    RetailYearEnd
+}
+
+// FiscalPeriod is a period of a fiscal year in a FiscalCalendar.
+type FiscalPeriod struct {
+	year    int
+	quarter int
+	period  int
+	dates   DateRange
+}
+
+// Factory functions
+// --------------------------------------------------
+
+// NewFiscalCalendar creates a new month-based FiscalCalendar whose fiscal years start in the specified month.
+// A month out of the range of January to December wraps around in the same way as NewFiscalQuarter,
+// so 0 is December and 13 is January.
+func NewFiscalCalendar(start time.Month) FiscalCalendar {
+	return FiscalCalendar{
+		start: monthIndex(start),
+	}
+}
+
+// NewRetailFiscalCalendar creates a new FiscalCalendar of 52-53 week fiscal years.
+// Each fiscal year ends on the endWeekday chosen by yearEnd in endMonth, and its periods follow the pattern.
+// For example, the NRF calendar is NewRetailFiscalCalendar(time.January, time.Saturday, RetailYearEndNearest, Pattern454).
+// The end month wraps around in the same way as NewFiscalCalendar.
+func NewRetailFiscalCalendar(endMonth time.Month, endWeekday time.Weekday, yearEnd RetailYearEnd, pattern RetailPattern) FiscalCalendar {
+	return FiscalCalendar{
+		start:      monthIndex(endMonth + 1),
+		retail:     true,
+		pattern:    pattern,
+		endWeekday: endWeekday,
+		yearEnd:    yearEnd,
+	}
+}
+
+// Determination methods
+// --------------------------------------------------
+
+// IsRetail checks if the FiscalCalendar instance is a 52-53 week retail calendar.
+func (c FiscalCalendar) IsRetail() bool {
+	return c.retail
+}
+
+// Conversion methods
+// --------------------------------------------------
+
+// StartMonth returns the month in which fiscal years start.
+// For retail calendars, it is the month following the end month, although fiscal years may start a few days earlier or later.
+func (c FiscalCalendar) StartMonth() time.Month {
+	return time.Month(c.start + 1)
+}
+
+// FiscalYear returns the fiscal year containing the specified date.
+func (c FiscalCalendar) FiscalYear(date Date) int {
+	return c.PeriodOf(date).FiscalYear()
+}
+
+// FiscalQuarter returns the fiscal quarter, from 1 to 4, containing the specified date.
+// Use QuarterOf to get it as a FiscalQuarter instance.
+func (c FiscalCalendar) FiscalQuarter(date Date) int {
+	return c.PeriodOf(date).Quarter()
+}
+
+// QuarterOf returns the FiscalQuarter containing the specified date.
+// FiscalQuarter only represents quarters of month-based fiscal years, so it returns ErrFiscalCalendarIsRetail for retail calendars.
+func (c FiscalCalendar) QuarterOf(date Date) (FiscalQuarter, error) {
+	if c.retail {
+		return FiscalQuarter{}, fmt.Errorf("QuarterOf: %w", ErrFiscalCalendarIsRetail)
+	}
+
+	return FiscalQuarterFromDate(date, c.StartMonth()), nil
+}
+
+// FiscalPeriod returns the fiscal period, from 1 to 12, containing the specified date.
+func (c FiscalCalendar) FiscalPeriod(date Date) int {
+	return c.PeriodOf(date).Period()
+}
+
+// PeriodOf returns the FiscalPeriod containing the specified date.
+func (c FiscalCalendar) PeriodOf(date Date) FiscalPeriod {
+	if !c.retail {
+		return c.PeriodOfMonth(date.ToMonth())
+	}
+
+	year := c.nominalFiscalYear(date.ToMonth())
+	for date.Before(c.YearRange(year).start) {
+		year--
+	}
+	for date.After(c.YearRange(year).end) {
+		year++
+	}
+
+	periods := c.Periods(year)
+	for _, p := range periods[:len(periods)-1] {
+		if p.dates.Contains(date) {
+			return p
+		}
+	}
+
+	return periods[len(periods)-1]
+}
+
+// PeriodOfMonth returns the FiscalPeriod of the specified month.
+// For retail calendars, whose periods are not aligned with months, it returns the period containing the 15th of the month.
+func (c FiscalCalendar) PeriodOfMonth(month Month) FiscalPeriod {
+	if c.retail {
+		return c.PeriodOf(month.On(15))
+	}
+
+	offset := c.monthOffset(month)
+
+	return FiscalPeriod{
+		year:    c.nominalFiscalYear(month),
+		quarter: offset/3 + 1,
+		period:  offset + 1,
+		dates:   month.ToDateRange(),
+	}
+}
+
+// Periods returns the twelve periods of the specified fiscal year.
+func (c FiscalCalendar) Periods(fiscalYear int) []FiscalPeriod {
+	periods := make([]FiscalPeriod, 12)
+
+	if !c.retail {
+		first := NewMonth(fiscalYear, c.StartMonth())
+		for i := range periods {
+			periods[i] = c.PeriodOfMonth(first.AddMonths(i))
+		}
+
+		return periods
+	}
+
+	year := c.YearRange(fiscalYear)
+	weeks := c.pattern.weeks()
+	start := year.start
+
+	for i := range periods {
+		end := start.AddWeeks(weeks[i%3]).SubDay()
+		if i == len(periods)-1 {
+			end = year.end
+		}
+
+		periods[i] = FiscalPeriod{
+			year:    fiscalYear,
+			quarter: i/3 + 1,
+			period:  i + 1,
+			dates:   DateRange{start, end},
+		}
+		start = end.AddDay()
+	}
+
+	return periods
+}
+
+// YearRange returns the DateRange of the specified fiscal year.
+func (c FiscalCalendar) YearRange(fiscalYear int) DateRange {
+	if !c.retail {
+		first := NewMonth(fiscalYear, c.StartMonth())
+
+		return DateRange{first.FirstDate(), first.AddMonths(11).LastDate()}
+	}
+
+	return DateRange{c.retailYearEnd(fiscalYear - 1).AddDay(), c.retailYearEnd(fiscalYear)}
+}
+
+// QuarterRange returns the DateRange of the specified quarter, from 1 to 4, of the fiscal year.
+// Quarters out of the range roll over to the adjacent fiscal years.
+func (c FiscalCalendar) QuarterRange(fiscalYear, quarter int) DateRange {
+	year, index := normalizeFiscalIndex(fiscalYear, quarter-1, 4)
+	periods := c.Periods(year)
+
+	return DateRange{periods[index*3].dates.start, periods[index*3+2].dates.end}
+}
+
+// PeriodRange returns the DateRange of the specified period, from 1 to 12, of the fiscal year.
+// Periods out of the range roll over to the adjacent fiscal years.
+func (c FiscalCalendar) PeriodRange(fiscalYear, period int) DateRange {
+	year, index := normalizeFiscalIndex(fiscalYear, period-1, 12)
+
+	return c.Periods(year)[index].dates
+}
+
+// Weeks returns the number of weeks in the specified fiscal year of a retail calendar, which is 52 or 53.
+// For month-based calendars, it returns 0.
+func (c FiscalCalendar) Weeks(fiscalYear int) int {
+	if !c.retail {
+		return 0
+	}
+
+	return c.YearRange(fiscalYear).Days() / 7
+}
+
+// nominalFiscalYear returns the fiscal year whose nominal months include the specified month.
+func (c FiscalCalendar) nominalFiscalYear(month Month) int {
+	return month.SubMonths(c.monthOffset(month)).Year()
+}
+
+// monthOffset returns the number of months from the start month to the specified month.
+func (c FiscalCalendar) monthOffset(month Month) int {
+	return (month.m - c.start + 12) % 12
+}
+
+// retailYearEnd returns the last day of the specified fiscal year of a retail calendar.
+func (c FiscalCalendar) retailYearEnd(fiscalYear int) Date {
+	endMonth := NewMonth(fiscalYear, c.StartMonth()).AddMonths(11)
+	last := endMonth.LastDate()
+	back := (int(last.Weekday()) - int(c.endWeekday) + 7) % 7
+
+	if c.yearEnd == RetailYearEndNearest && back > 3 {
+		return last.AddDays(7 - back)
+	}
+
+	return last.SubDays(back)
+}
+
+// normalizeFiscalIndex rolls a zero-based index over to the adjacent fiscal years.
+func normalizeFiscalIndex(fiscalYear, index, size int) (int, int) {
+	year := fiscalYear + index/size
+	index %= size
+	if index < 0 {
+		year--
+		index += size
+	}
+
+	return year, index
+}
+
+// Conversion methods of FiscalPeriod
+// --------------------------------------------------
+
+// FiscalYear returns the fiscal year of the FiscalPeriod instance.
+func (p FiscalPeriod) FiscalYear() int {
+	return p.year
+}
+
+// Quarter returns the fiscal quarter, from 1 to 4, of the FiscalPeriod instance.
+func (p FiscalPeriod) Quarter() int {
+	return p.quarter
+}
+
+// Period returns the period number, from 1 to 12, of the FiscalPeriod instance.
+func (p FiscalPeriod) Period() int {
+	return p.period
+}
+
+// ToDateRange returns the DateRange of the FiscalPeriod instance.
+func (p FiscalPeriod) ToDateRange() DateRange {
+	return p.dates
+}
+
+// String returns the string representation of the FiscalPeriod instance in the format "FY2006-P01".
+func (p FiscalPeriod) String() string {
+	return fmt.Sprintf("FY%04d-P%02d", p.year, p.period)
+}
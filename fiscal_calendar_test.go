@@ -0,0 +1,256 @@
+package date
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func nrfCalendar() FiscalCalendar {
+	return NewRetailFiscalCalendar(time.January, time.Saturday, RetailYearEndNearest, Pattern454)
+}
+
+func TestRetailPatternString(t *testing.T) {
+	tests := []struct {
+		pattern RetailPattern
+		want    string
+	}{
+		{Pattern445, "4-4-5"},
+		{Pattern454, "4-5-4"},
+		{Pattern544, "5-4-4"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf("RetailPattern(%d).String()", tt.pattern)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.pattern.String())
+		})
+	}
+}
+
+func TestFiscalCalendarIsRetail(t *testing.T) {
+	t.Run("FiscalCalendar.IsRetail()", func(t *testing.T) {
+		assert.False(t, NewFiscalCalendar(time.April).IsRetail())
+		assert.True(t, nrfCalendar().IsRetail())
+	})
+}
+
+func TestFiscalCalendarStartMonth(t *testing.T) {
+	tests := []struct {
+		calendar FiscalCalendar
+		want     time.Month
+	}{
+		{FiscalCalendar{}, time.January},
+		{NewFiscalCalendar(time.April), time.April},
+		{NewFiscalCalendar(time.October), time.October},
+		{nrfCalendar(), time.February},
+		{NewRetailFiscalCalendar(time.December, time.Saturday, RetailYearEndLast, Pattern445), time.January},
+		{NewFiscalCalendar(time.Month(0)), time.December},
+		{NewFiscalCalendar(time.Month(13)), time.January},
+		{NewFiscalCalendar(time.Month(-8)), time.April},
+		{NewRetailFiscalCalendar(time.Month(0), time.Saturday, RetailYearEndLast, Pattern445), time.January},
+		{NewRetailFiscalCalendar(time.Month(13), time.Saturday, RetailYearEndLast, Pattern445), time.February},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf("FiscalCalendar{%s}.StartMonth()", tt.want)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.calendar.StartMonth())
+		})
+	}
+}
+
+func TestFiscalCalendarPeriodOf(t *testing.T) {
+	tests := []struct {
+		name     string
+		calendar FiscalCalendar
+		date     Date
+		year     int
+		quarter  int
+		period   int
+		dates    string
+	}{
+		{"April", NewFiscalCalendar(time.April), MustParse("2024-04-01"), 2024, 1, 1, "2024-04-01/2024-04-30"},
+		{"April", NewFiscalCalendar(time.April), MustParse("2025-02-10"), 2024, 4, 11, "2025-02-01/2025-02-28"},
+		{"April", NewFiscalCalendar(time.April), MustParse("2024-03-31"), 2023, 4, 12, "2024-03-01/2024-03-31"},
+		{"October", NewFiscalCalendar(time.October), MustParse("2024-11-05"), 2024, 1, 2, "2024-11-01/2024-11-30"},
+		{"January", FiscalCalendar{}, MustParse("2024-07-15"), 2024, 3, 7, "2024-07-01/2024-07-31"},
+		{"NRF", nrfCalendar(), MustParse("2023-01-29"), 2023, 1, 1, "2023-01-29/2023-02-25"},
+		{"NRF", nrfCalendar(), MustParse("2023-03-01"), 2023, 1, 2, "2023-02-26/2023-04-01"},
+		{"NRF", nrfCalendar(), MustParse("2024-02-03"), 2023, 4, 12, "2023-12-31/2024-02-03"},
+		{"NRF", nrfCalendar(), MustParse("2024-02-04"), 2024, 1, 1, "2024-02-04/2024-03-02"},
+		{"4-4-5 Dec", NewRetailFiscalCalendar(time.December, time.Saturday, RetailYearEndLast, Pattern445), MustParse("2023-12-31"), 2024, 1, 1, "2023-12-31/2024-01-27"},
+		{"4-4-5 Dec", NewRetailFiscalCalendar(time.December, time.Saturday, RetailYearEndLast, Pattern445), MustParse("2024-03-01"), 2024, 1, 3, "2024-02-25/2024-03-30"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`FiscalCalendar{%s}.PeriodOf(Date{"%s"})`, tt.name, tt.date)
+
+		t.Run(testcase, func(t *testing.T) {
+			p := tt.calendar.PeriodOf(tt.date)
+			assert.Equal(t, tt.year, p.FiscalYear(), "FiscalYear")
+			assert.Equal(t, tt.quarter, p.Quarter(), "Quarter")
+			assert.Equal(t, tt.period, p.Period(), "Period")
+			assert.Equal(t, tt.dates, p.ToDateRange().String(), "ToDateRange")
+
+			assert.Equal(t, tt.year, tt.calendar.FiscalYear(tt.date), "FiscalCalendar.FiscalYear")
+			assert.Equal(t, tt.quarter, tt.calendar.FiscalQuarter(tt.date), "FiscalCalendar.FiscalQuarter")
+			assert.Equal(t, tt.period, tt.calendar.FiscalPeriod(tt.date), "FiscalCalendar.FiscalPeriod")
+		})
+	}
+}
+
+func TestFiscalCalendarQuarterOf(t *testing.T) {
+	tests := []struct {
+		calendar FiscalCalendar
+		date     Date
+		want     string
+	}{
+		{NewFiscalCalendar(time.April), MustParse("2025-02-10"), "FY2024-Q4"},
+		{NewFiscalCalendar(time.October), MustParse("2024-11-05"), "FY2024-Q1"},
+		{FiscalCalendar{}, MustParse("2024-07-15"), "FY2024-Q3"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`FiscalCalendar{%s}.QuarterOf(Date{"%s"})`, tt.calendar.StartMonth(), tt.date)
+
+		t.Run(testcase, func(t *testing.T) {
+			q, err := tt.calendar.QuarterOf(tt.date)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, q.String())
+			assert.Equal(t, tt.calendar.FiscalYear(tt.date), q.FiscalYear(), "FiscalYear")
+			assert.Equal(t, tt.calendar.FiscalQuarter(tt.date), q.Quarter(), "Quarter")
+			assert.True(t, tt.calendar.QuarterRange(q.FiscalYear(), q.Quarter()).Equal(q.ToDateRange()), "QuarterRange")
+			assert.Equal(t, tt.calendar, q.FiscalCalendar(), "FiscalCalendar")
+		})
+	}
+
+	_, err := nrfCalendar().QuarterOf(MustParse("2024-02-04"))
+	assert.ErrorIs(t, err, ErrFiscalCalendarIsRetail)
+}
+
+func TestFiscalCalendarPeriodOfMonth(t *testing.T) {
+	tests := []struct {
+		name     string
+		calendar FiscalCalendar
+		month    Month
+		want     string
+		dates    string
+	}{
+		{"April", NewFiscalCalendar(time.April), MustParseMonth("2024-06"), "FY2024-P03", "2024-06-01/2024-06-30"},
+		{"April", NewFiscalCalendar(time.April), MustParseMonth("2025-01"), "FY2024-P10", "2025-01-01/2025-01-31"},
+		{"NRF", nrfCalendar(), MustParseMonth("2024-03"), "FY2024-P02", "2024-03-03/2024-04-06"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`FiscalCalendar{%s}.PeriodOfMonth(Month{"%s"})`, tt.name, tt.month)
+
+		t.Run(testcase, func(t *testing.T) {
+			p := tt.calendar.PeriodOfMonth(tt.month)
+			assert.Equal(t, tt.want, p.String())
+			assert.Equal(t, tt.dates, p.ToDateRange().String())
+		})
+	}
+}
+
+func TestFiscalCalendarPeriods(t *testing.T) {
+	t.Run(`FiscalCalendar{April}.Periods(2024)`, func(t *testing.T) {
+		periods := NewFiscalCalendar(time.April).Periods(2024)
+
+		assert.Len(t, periods, 12)
+		assert.Equal(t, "FY2024-P01", periods[0].String())
+		assert.Equal(t, "2024-04-01/2024-04-30", periods[0].ToDateRange().String())
+		assert.Equal(t, "2025-03-01/2025-03-31", periods[11].ToDateRange().String())
+	})
+
+	t.Run(`FiscalCalendar{NRF}.Periods(2023)`, func(t *testing.T) {
+		periods := nrfCalendar().Periods(2023)
+
+		weeks := make([]int, 0)
+		for i, p := range periods {
+			weeks = append(weeks, p.ToDateRange().Days()/7)
+			if i > 0 {
+				assert.Equal(t, periods[i-1].ToDateRange().End().AddDay(), p.ToDateRange().Start(), "periods are contiguous")
+			}
+		}
+		assert.Equal(t, []int{4, 5, 4, 4, 5, 4, 4, 5, 4, 4, 5, 5}, weeks)
+	})
+}
+
+func TestFiscalCalendarYearRange(t *testing.T) {
+	tests := []struct {
+		name     string
+		calendar FiscalCalendar
+		year     int
+		want     string
+		weeks    int
+	}{
+		{"April", NewFiscalCalendar(time.April), 2024, "2024-04-01/2025-03-31", 0},
+		{"January", FiscalCalendar{}, 2024, "2024-01-01/2024-12-31", 0},
+		{"NRF", nrfCalendar(), 2023, "2023-01-29/2024-02-03", 53},
+		{"NRF", nrfCalendar(), 2024, "2024-02-04/2025-02-01", 52},
+		{"4-4-5 Dec", NewRetailFiscalCalendar(time.December, time.Saturday, RetailYearEndLast, Pattern445), 2024, "2023-12-31/2024-12-28", 52},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`FiscalCalendar{%s}.YearRange(%d)`, tt.name, tt.year)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.calendar.YearRange(tt.year).String())
+			assert.Equal(t, tt.weeks, tt.calendar.Weeks(tt.year), "Weeks")
+		})
+	}
+}
+
+func TestFiscalCalendarQuarterRange(t *testing.T) {
+	tests := []struct {
+		name     string
+		calendar FiscalCalendar
+		year     int
+		quarter  int
+		want     string
+	}{
+		{"April", NewFiscalCalendar(time.April), 2024, 1, "2024-04-01/2024-06-30"},
+		{"April", NewFiscalCalendar(time.April), 2024, 4, "2025-01-01/2025-03-31"},
+		{"April", NewFiscalCalendar(time.April), 2024, 5, "2025-04-01/2025-06-30"},
+		{"April", NewFiscalCalendar(time.April), 2024, 0, "2024-01-01/2024-03-31"},
+		{"NRF", nrfCalendar(), 2023, 1, "2023-01-29/2023-04-29"},
+		{"NRF", nrfCalendar(), 2023, 4, "2023-10-29/2024-02-03"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`FiscalCalendar{%s}.QuarterRange(%d, %d)`, tt.name, tt.year, tt.quarter)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.calendar.QuarterRange(tt.year, tt.quarter).String())
+		})
+	}
+}
+
+func TestFiscalCalendarPeriodRange(t *testing.T) {
+	tests := []struct {
+		name     string
+		calendar FiscalCalendar
+		year     int
+		period   int
+		want     string
+	}{
+		{"April", NewFiscalCalendar(time.April), 2024, 12, "2025-03-01/2025-03-31"},
+		{"April", NewFiscalCalendar(time.April), 2024, 0, "2024-03-01/2024-03-31"},
+		{"April", NewFiscalCalendar(time.April), 2024, 13, "2025-04-01/2025-04-30"},
+		{"NRF", nrfCalendar(), 2024, 2, "2024-03-03/2024-04-06"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`FiscalCalendar{%s}.PeriodRange(%d, %d)`, tt.name, tt.year, tt.period)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.calendar.PeriodRange(tt.year, tt.period).String())
+		})
+	}
+}
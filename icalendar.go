@@ -0,0 +1,410 @@
+package date
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+var (
+	ErrICalendarMalformed           = fmt.Errorf("malformed iCalendar data")
+	ErrICalendarUnboundedRecurrence = fmt.Errorf("recurring event without COUNT or UNTIL")
+)
+
+const (
+	icalendarDateLayout     = "20060102"
+	icalendarDateTimeLayout = "20060102T150405Z"
+	icalendarProductID      = "-//yuuan//go-date//EN"
+	icalendarLineLength     = 75
+)
+
+// icalendarProperty is a content line of iCalendar data.
+type icalendarProperty struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+// Reading functions
+// --------------------------------------------------
+
+// ReadICalendar reads iCalendar (RFC 5545) data and returns a ListCalendar of its all-day VEVENT components.
+// The SUMMARY of each event becomes the name of its entry, and the exclusive DTEND is converted to an inclusive end date.
+// Events without DTEND last one day unless DURATION is specified.
+// Timed and cancelled events are skipped.
+//
+// Recurring events are expanded into an entry per occurrence using RRULE, RDATE and EXDATE.
+// An RRULE without COUNT or UNTIL repeats forever, so it returns ErrICalendarUnboundedRecurrence;
+// use ReadICalendarIn to read such data.
+func ReadICalendar(r io.Reader) (*ListCalendar, error) {
+	entries, err := readICalendarEntries(r, nil)
+	if err != nil {
+		return nil, fmt.Errorf("ReadICalendar: %w", err)
+	}
+
+	return NewListCalendar(entries...), nil
+}
+
+// ReadICalendarIn reads iCalendar data in the same way as ReadICalendar,
+// but returns only the entries overlapping the specified DateRange.
+// Recurring events are expanded within the DateRange, so RRULEs without COUNT or UNTIL are also supported.
+func ReadICalendarIn(r io.Reader, period DateRange) (*ListCalendar, error) {
+	entries, err := readICalendarEntries(r, &period)
+	if err != nil {
+		return nil, fmt.Errorf("ReadICalendarIn: %w", err)
+	}
+
+	return NewListCalendar(entries...), nil
+}
+
+// readICalendarEntries reads the entries of all-day VEVENT components.
+// If period is not nil, only the entries overlapping it are returned.
+func readICalendarEntries(r io.Reader, period *DateRange) ([]CalendarEntry, error) {
+	lines, err := unfoldICalendarLines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]CalendarEntry, 0)
+	var event []icalendarProperty
+	depth := 0
+
+	for i, line := range lines {
+		prop, err := parseICalendarProperty(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+
+		switch {
+		case prop.name == "BEGIN" && strings.EqualFold(prop.value, "VEVENT") && depth == 0:
+			event = make([]icalendarProperty, 0)
+			depth = 1
+
+		case prop.name == "BEGIN" && depth > 0:
+			depth++
+
+		case prop.name == "END" && depth > 1:
+			depth--
+
+		case prop.name == "END" && depth == 1:
+			depth = 0
+
+			es, err := icalendarEntriesOf(event, period)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", i+1, err)
+			}
+			entries = append(entries, es...)
+
+		case depth == 1:
+			event = append(event, prop)
+		}
+	}
+
+	return entries, nil
+}
+
+// icalendarEntriesOf converts the properties of a VEVENT component into CalendarEntries, one for each occurrence.
+// It returns no entries for events which are not all-day events to be imported.
+// If period is not nil, only the entries overlapping it are returned.
+func icalendarEntriesOf(props []icalendarProperty, period *DateRange) ([]CalendarEntry, error) {
+	var summary, start, end, duration, rule string
+	var exDates, rDates Dates
+
+	for _, prop := range props {
+		switch prop.name {
+		case "SUMMARY":
+			summary = unescapeICalendarText(prop.value)
+		case "DTSTART":
+			start = prop.value
+		case "DTEND":
+			end = prop.value
+		case "DURATION":
+			duration = prop.value
+		case "RRULE":
+			rule = prop.value
+		case "EXDATE", "RDATE":
+			for _, value := range strings.Split(prop.value, ",") {
+				d, err := parseRecurrenceDate(value)
+				if err != nil {
+					return nil, fmt.Errorf("invalid %s: %w", prop.name, err)
+				}
+
+				if prop.name == "EXDATE" {
+					exDates = append(exDates, d)
+				} else {
+					rDates = append(rDates, d)
+				}
+			}
+		case "STATUS":
+			if strings.EqualFold(prop.value, "CANCELLED") {
+				return nil, nil
+			}
+		}
+	}
+
+	if start == "" {
+		return nil, fmt.Errorf("VEVENT without DTSTART: %w", ErrICalendarMalformed)
+	}
+	if len(start) != len(icalendarDateLayout) {
+		return nil, nil
+	}
+
+	s, err := CustomParse(icalendarDateLayout, start)
+	if err != nil {
+		return nil, fmt.Errorf("invalid DTSTART: %w", err)
+	}
+
+	e := s
+	switch {
+	case end != "":
+		exclusive, err := CustomParse(icalendarDateLayout, end)
+		if err != nil {
+			return nil, fmt.Errorf("invalid DTEND: %w", err)
+		}
+
+		e = exclusive.SubDay()
+
+	case duration != "":
+		days, err := parseICalendarDays(duration)
+		if err != nil {
+			return nil, fmt.Errorf("invalid DURATION %q: %w", duration, err)
+		}
+
+		e = s.AddDays(days - 1)
+	}
+
+	first, err := NewDateRange(s, e)
+	if err != nil {
+		return nil, fmt.Errorf("invalid period of VEVENT: %w", err)
+	}
+
+	starts, err := icalendarOccurrences(s, rule, rDates, exDates, first.Days(), period)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]CalendarEntry, 0, len(starts))
+	for _, d := range starts {
+		r := DateRange{d, d.AddDays(first.Days() - 1)}
+		if period == nil || r.OverlapsWith(*period) {
+			entries = append(entries, NewCalendarEntry(summary, r))
+		}
+	}
+
+	return entries, nil
+}
+
+// icalendarOccurrences returns the start dates of the occurrences of an event lasting the specified number of days.
+// If period is not nil, the occurrences are expanded only as far as needed to cover it.
+func icalendarOccurrences(start Date, rule string, rDates, exDates Dates, days int, period *DateRange) (Dates, error) {
+	if rule == "" {
+		starts := make(Dates, 0, len(rDates)+1)
+
+		for _, d := range append(Dates{start}, rDates...) {
+			if !exDates.contains(d) && !starts.contains(d) {
+				starts = append(starts, d)
+			}
+		}
+
+		return starts.SortMutable(), nil
+	}
+
+	rec, err := ParseRecurrence(start, rule)
+	if err != nil {
+		return nil, fmt.Errorf("invalid RRULE: %w", err)
+	}
+	rec = rec.WithExDates(exDates...).WithRDates(rDates...)
+
+	if period != nil {
+		return rec.DatesIn(DateRange{period.start.SubDays(days - 1), period.end}), nil
+	}
+
+	if rec.count == 0 && rec.until.IsZero() {
+		return nil, fmt.Errorf("RRULE %q: %w", rule, ErrICalendarUnboundedRecurrence)
+	}
+
+	from := start
+	for _, d := range rDates {
+		if d.Before(from) {
+			from = d
+		}
+	}
+
+	return rec.DatesIn(DateRange{from, NewDate(9999, time.December, 31)}), nil
+}
+
+// unfoldICalendarLines reads content lines, joining folded lines and dropping empty ones.
+func unfoldICalendarLines(r io.Reader) ([]string, error) {
+	scanner := bufio.NewScanner(r)
+	lines := make([]string, 0)
+
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+
+			continue
+		}
+
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read iCalendar data: %w", err)
+	}
+
+	return lines, nil
+}
+
+// parseICalendarProperty parses a content line in the form "NAME;PARAM=VALUE:VALUE".
+func parseICalendarProperty(line string) (icalendarProperty, error) {
+	quoted := false
+	colon := -1
+
+	for i, c := range line {
+		if c == '"' {
+			quoted = !quoted
+		} else if c == ':' && !quoted {
+			colon = i
+
+			break
+		}
+	}
+
+	if colon < 0 {
+		return icalendarProperty{}, fmt.Errorf("content line %q has no value: %w", line, ErrICalendarMalformed)
+	}
+
+	parts := strings.Split(line[:colon], ";")
+	prop := icalendarProperty{
+		name:   strings.ToUpper(parts[0]),
+		params: make(map[string]string, len(parts)-1),
+		value:  line[colon+1:],
+	}
+
+	for _, param := range parts[1:] {
+		if key, value, ok := strings.Cut(param, "="); ok {
+			prop.params[strings.ToUpper(key)] = strings.Trim(value, `"`)
+		}
+	}
+
+	return prop, nil
+}
+
+// parseICalendarDays parses a DURATION value made of days or weeks, such as "P1D" or "P2W".
+func parseICalendarDays(value string) (int, error) {
+	v := strings.TrimPrefix(value, "+")
+	if !strings.HasPrefix(v, "P") || len(v) < 3 {
+		return 0, ErrICalendarMalformed
+	}
+
+	unit := v[len(v)-1]
+	n, err := strconv.Atoi(v[1 : len(v)-1])
+	if err != nil || n <= 0 {
+		return 0, ErrICalendarMalformed
+	}
+
+	switch unit {
+	case 'D':
+		return n, nil
+	case 'W':
+		return n * 7, nil
+	}
+
+	return 0, ErrICalendarMalformed
+}
+
+// unescapeICalendarText unescapes a TEXT value.
+func unescapeICalendarText(value string) string {
+	return strings.NewReplacer(`\\`, `\`, `\;`, `;`, `\,`, `,`, `\n`, "\n", `\N`, "\n").Replace(value)
+}
+
+// Writing functions
+// --------------------------------------------------
+
+// WriteICalendar writes the entries as all-day VEVENT components of iCalendar (RFC 5545) data.
+// The inclusive end date of each entry is written as the exclusive DTEND.
+func WriteICalendar(w io.Writer, entries ...CalendarEntry) error {
+	bw := bufio.NewWriter(w)
+	stamp := Now().UTC().Format(icalendarDateTimeLayout)
+
+	writeICalendarLine(bw, "BEGIN:VCALENDAR")
+	writeICalendarLine(bw, "VERSION:2.0")
+	writeICalendarLine(bw, "PRODID:"+icalendarProductID)
+	writeICalendarLine(bw, "CALSCALE:GREGORIAN")
+
+	for i, e := range entries {
+		start := e.period.start.Format(icalendarDateLayout)
+		end := e.period.end.AddDay().Format(icalendarDateLayout)
+
+		writeICalendarLine(bw, "BEGIN:VEVENT")
+		writeICalendarLine(bw, fmt.Sprintf("UID:%s-%s-%d@github.com/yuuan/go-date", start, end, i))
+		writeICalendarLine(bw, "DTSTAMP:"+stamp)
+		writeICalendarLine(bw, "DTSTART;VALUE=DATE:"+start)
+		writeICalendarLine(bw, "DTEND;VALUE=DATE:"+end)
+		writeICalendarLine(bw, "SUMMARY:"+escapeICalendarText(e.name))
+		writeICalendarLine(bw, "END:VEVENT")
+	}
+
+	writeICalendarLine(bw, "END:VCALENDAR")
+
+	if err := bw.Flush(); err != nil {
+		return fmt.Errorf("WriteICalendar: %w", err)
+	}
+
+	return nil
+}
+
+// WriteICalendarDates writes each date as a one-day VEVENT component with the specified summary.
+func WriteICalendarDates(w io.Writer, summary string, dates Dates) error {
+	entries := make([]CalendarEntry, len(dates))
+
+	for i, d := range dates {
+		entries[i] = NewCalendarEntry(summary, DateRange{d, d})
+	}
+
+	return WriteICalendar(w, entries...)
+}
+
+// WriteICalendarDateRanges writes each DateRange as an all-day VEVENT component with the specified summary.
+func WriteICalendarDateRanges(w io.Writer, summary string, ranges DateRanges) error {
+	entries := make([]CalendarEntry, len(ranges))
+
+	for i, r := range ranges {
+		entries[i] = NewCalendarEntry(summary, r)
+	}
+
+	return WriteICalendar(w, entries...)
+}
+
+// writeICalendarLine writes a content line terminated by CRLF, folding it at 75 octets without splitting characters.
+// Errors are reported by the Flush of the bufio.Writer.
+func writeICalendarLine(w *bufio.Writer, line string) {
+	limit := icalendarLineLength
+
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+
+		_, _ = w.WriteString(line[:cut] + "\r\n ")
+		line = line[cut:]
+		// Continuation lines start with a space, which counts towards the limit.
+		limit = icalendarLineLength - 1
+	}
+
+	_, _ = w.WriteString(line + "\r\n")
+}
+
+// escapeICalendarText escapes a TEXT value.
+func escapeICalendarText(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `;`, `\;`, `,`, `\,`, "\r\n", `\n`, "\n", `\n`).Replace(value)
+}
@@ -0,0 +1,266 @@
+package date
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Reading functions
+// --------------------------------------------------
+
+func TestReadICalendar(t *testing.T) {
+	ics := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"BEGIN:VEVENT",
+		"DTSTART;VALUE=DATE:20240101",
+		"DTEND;VALUE=DATE:20240102",
+		"SUMMARY:New Year's Day",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"DTSTART;VALUE=DATE:20240813",
+		"DTEND;VALUE=DATE:20240816",
+		"SUMMARY:Summer Break\\, Tokyo",
+		" Office",
+		"BEGIN:VALARM",
+		"ACTION:DISPLAY",
+		"DESCRIPTION:Reminder",
+		"END:VALARM",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"DTSTART;VALUE=DATE:20241001",
+		"SUMMARY:Foundation Day",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"DTSTART;VALUE=DATE:20241225",
+		"DURATION:P2D",
+		"SUMMARY:Winter Break",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"DTSTART;TZID=\"Asia/Tokyo\":20240605T090000",
+		"DTEND;TZID=\"Asia/Tokyo\":20240605T100000",
+		"SUMMARY:Meeting",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"DTSTART;VALUE=DATE:20240606",
+		"STATUS:CANCELLED",
+		"SUMMARY:Cancelled",
+		"END:VEVENT",
+		"END:VCALENDAR",
+	}, "\r\n")
+
+	cal, err := ReadICalendar(strings.NewReader(ics))
+
+	assert.NoError(t, err)
+
+	tests := []struct {
+		date string
+		want string
+	}{
+		{"2024-01-01", "New Year's Day"},
+		{"2024-01-02", ""},
+		{"2024-08-13", "Summer Break, TokyoOffice"},
+		{"2024-08-15", "Summer Break, TokyoOffice"},
+		{"2024-08-16", ""},
+		{"2024-10-01", "Foundation Day"},
+		{"2024-12-26", "Winter Break"},
+		{"2024-12-27", ""},
+		{"2024-06-05", ""},
+		{"2024-06-06", ""},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`ReadICalendar().HolidayName(Date{"%s"})`, tt.date)
+
+		t.Run(testcase, func(t *testing.T) {
+			name, ok := cal.HolidayName(MustParse(tt.date))
+
+			assert.Equal(t, tt.want != "", ok)
+			assert.Equal(t, tt.want, name)
+		})
+	}
+}
+
+func TestReadICalendarRecurrence(t *testing.T) {
+	ics := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"BEGIN:VEVENT",
+		"DTSTART;VALUE=DATE:20240101",
+		"DTEND;VALUE=DATE:20240102",
+		"RRULE:FREQ=YEARLY;COUNT=3",
+		"EXDATE;VALUE=DATE:20250101",
+		"SUMMARY:New Year's Day",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"DTSTART;VALUE=DATE:20240813",
+		"DTEND;VALUE=DATE:20240815",
+		"RRULE:FREQ=YEARLY;UNTIL=20250813",
+		"RDATE;VALUE=DATE:20261228",
+		"SUMMARY:Summer Break",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"DTSTART;VALUE=DATE:20240401",
+		"RDATE;VALUE=DATE:20240501,20240601",
+		"SUMMARY:Founding Day",
+		"END:VEVENT",
+		"END:VCALENDAR",
+	}, "\r\n")
+
+	cal, err := ReadICalendar(strings.NewReader(ics))
+
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		[]string{
+			"2024-01-01", "2024-04-01", "2024-05-01", "2024-06-01", "2024-08-13", "2024-08-14",
+			"2025-08-13", "2025-08-14", "2026-01-01", "2026-12-28", "2026-12-29",
+		},
+		cal.Dates().Strings(),
+	)
+}
+
+func TestReadICalendarIn(t *testing.T) {
+	ics := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"BEGIN:VEVENT",
+		"DTSTART;VALUE=DATE:20231230",
+		"DTEND;VALUE=DATE:20240104",
+		"RRULE:FREQ=YEARLY",
+		"SUMMARY:Year-end Holidays",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"DTSTART;VALUE=DATE:20240605",
+		"SUMMARY:Outside",
+		"END:VEVENT",
+		"END:VCALENDAR",
+	}, "\r\n")
+
+	_, err := ReadICalendar(strings.NewReader(ics))
+	assert.ErrorIs(t, err, ErrICalendarUnboundedRecurrence)
+
+	cal, err := ReadICalendarIn(strings.NewReader(ics), MustParseDateRange("2025-01-01", "2025-12-31"))
+
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		[]string{
+			"2024-12-30", "2024-12-31", "2025-01-01", "2025-01-02", "2025-01-03",
+			"2025-12-30", "2025-12-31", "2026-01-01", "2026-01-02", "2026-01-03",
+		},
+		cal.Dates().Strings(),
+	)
+}
+
+func TestReadICalendarError(t *testing.T) {
+	tests := []string{
+		"BEGIN:VCALENDAR\nBEGIN:VEVENT\nSUMMARY:No start\nEND:VEVENT\nEND:VCALENDAR",
+		"BEGIN:VCALENDAR\nBEGIN:VEVENT\nDTSTART;VALUE=DATE:20241301\nEND:VEVENT\nEND:VCALENDAR",
+		"BEGIN:VCALENDAR\nBEGIN:VEVENT\nDTSTART;VALUE=DATE:20240105\nDTEND;VALUE=DATE:20240105\nEND:VEVENT\nEND:VCALENDAR",
+		"BEGIN:VCALENDAR\nBEGIN:VEVENT\nDTSTART;VALUE=DATE:20240105\nDURATION:PT1H\nEND:VEVENT\nEND:VCALENDAR",
+		"BEGIN:VCALENDAR\nINVALID LINE\nEND:VCALENDAR",
+		"BEGIN:VCALENDAR\nBEGIN:VEVENT\nDTSTART;VALUE=DATE:20240105\nRRULE:FREQ=SECONDLY;COUNT=2\nEND:VEVENT\nEND:VCALENDAR",
+		"BEGIN:VCALENDAR\nBEGIN:VEVENT\nDTSTART;VALUE=DATE:20240105\nEXDATE;VALUE=DATE:2024010\nEND:VEVENT\nEND:VCALENDAR",
+	}
+
+	for _, ics := range tests {
+		testcase := fmt.Sprintf("ReadICalendar(%q)", ics)
+
+		t.Run(testcase, func(t *testing.T) {
+			_, err := ReadICalendar(strings.NewReader(ics))
+
+			assert.Error(t, err)
+		})
+	}
+}
+
+// Writing functions
+// --------------------------------------------------
+
+func TestWriteICalendar(t *testing.T) {
+	SetTestNow(func() time.Time { return time.Date(2024, time.June, 5, 12, 0, 0, 0, time.UTC) })
+	defer ResetTestNow()
+
+	t.Run("WriteICalendar()", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		err := WriteICalendar(&buf, NewCalendarEntry("Summer Break; Tokyo", MustParseDateRange("2024-08-13", "2024-08-15")))
+
+		want := strings.Join([]string{
+			"BEGIN:VCALENDAR",
+			"VERSION:2.0",
+			"PRODID:-//yuuan//go-date//EN",
+			"CALSCALE:GREGORIAN",
+			"BEGIN:VEVENT",
+			"UID:20240813-20240816-0@github.com/yuuan/go-date",
+			"DTSTAMP:20240605T120000Z",
+			"DTSTART;VALUE=DATE:20240813",
+			"DTEND;VALUE=DATE:20240816",
+			`SUMMARY:Summer Break\; Tokyo`,
+			"END:VEVENT",
+			"END:VCALENDAR",
+			"",
+		}, "\r\n")
+
+		assert.NoError(t, err)
+		assert.Equal(t, want, buf.String())
+	})
+
+	t.Run("WriteICalendar() folds long lines", func(t *testing.T) {
+		var buf bytes.Buffer
+		name := strings.Repeat("休日", 30)
+
+		err := WriteICalendar(&buf, NewCalendarEntry(name, MustParseDateRange("2024-08-13", "2024-08-13")))
+		assert.NoError(t, err)
+
+		for _, line := range strings.Split(buf.String(), "\r\n") {
+			assert.LessOrEqual(t, len(line), 75)
+		}
+
+		cal, err := ReadICalendar(&buf)
+		assert.NoError(t, err)
+
+		got, _ := cal.HolidayName(MustParse("2024-08-13"))
+		assert.Equal(t, name, got)
+	})
+}
+
+func TestWriteICalendarDates(t *testing.T) {
+	t.Run("WriteICalendarDates()", func(t *testing.T) {
+		var buf bytes.Buffer
+
+		err := WriteICalendarDates(&buf, "Holiday", Dates{MustParse("2024-01-01"), MustParse("2024-01-08")})
+		assert.NoError(t, err)
+
+		cal, err := ReadICalendar(&buf)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"2024-01-01", "2024-01-08"}, cal.Dates().Strings())
+	})
+}
+
+func TestWriteICalendarDateRanges(t *testing.T) {
+	t.Run("WriteICalendarDateRanges()", func(t *testing.T) {
+		var buf bytes.Buffer
+		ranges := DateRanges{
+			MustParseDateRange("2024-08-13", "2024-08-15"),
+			MustParseDateRange("2024-12-28", "2025-01-03"),
+		}
+
+		err := WriteICalendarDateRanges(&buf, "Closed", ranges)
+		assert.NoError(t, err)
+
+		cal, err := ReadICalendar(&buf)
+		assert.NoError(t, err)
+
+		entries := cal.Entries()
+		assert.Len(t, entries, 2)
+		for i, e := range entries {
+			assert.Equal(t, "Closed", e.Name())
+			assert.Equal(t, ranges[i].String(), e.DateRange().String())
+		}
+	})
+}
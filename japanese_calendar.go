@@ -0,0 +1,274 @@
+package date
+
+import (
+	"sync"
+	"time"
+)
+
+// JapaneseCalendar is a NamedCalendar of the national holidays of Japan.
+// It follows the Act on National Holidays (国民の祝日に関する法律) from its enforcement in 1948,
+// including substitute holidays (振替休日), citizens' holidays (国民の休日) and one-off changes such as the 2020/2021 Olympic moves.
+// The equinox days can only be computed between 1900 and 2150.
+// The zero value is ready to use.
+type JapaneseCalendar struct {
+	mu    sync.Mutex
+	years map[int]map[monthDay]string
+}
+
+// monthDay is a month and day without a year.
+type monthDay struct {
+	month time.Month
+	day   int
+}
+
+// japaneseHolidayRule describes a national holiday (国民の祝日) valid from one year to another.
+type japaneseHolidayRule struct {
+	name string
+	from int
+	to   int
+	day  func(year int) (time.Month, int, bool)
+}
+
+const (
+	japaneseHolidayLawYear = 1948
+	japaneseHolidayMaxYear = 9999
+)
+
+var (
+	// substituteHolidayStart is the date on which substitute holidays (振替休日) were introduced.
+	substituteHolidayStart = time.Date(1973, time.April, 12, 0, 0, 0, 0, time.UTC)
+	// citizensHolidayStart is the date on which citizens' holidays (国民の休日) were introduced.
+	citizensHolidayStart = time.Date(1985, time.December, 27, 0, 0, 0, 0, time.UTC)
+)
+
+var japaneseHolidayRules = []japaneseHolidayRule{
+	{"元日", 1949, japaneseHolidayMaxYear, fixedDay(time.January, 1)},
+	{"成人の日", 1949, 1999, fixedDay(time.January, 15)},
+	{"成人の日", 2000, japaneseHolidayMaxYear, nthMonday(time.January, 2)},
+	{"建国記念の日", 1967, japaneseHolidayMaxYear, fixedDay(time.February, 11)},
+	{"天皇誕生日", 1949, 1988, fixedDay(time.April, 29)},
+	{"天皇誕生日", 1989, 2018, fixedDay(time.December, 23)},
+	{"天皇誕生日", 2020, japaneseHolidayMaxYear, fixedDay(time.February, 23)},
+	{"春分の日", 1949, japaneseHolidayMaxYear, vernalEquinoxDay},
+	{"みどりの日", 1989, 2006, fixedDay(time.April, 29)},
+	{"昭和の日", 2007, japaneseHolidayMaxYear, fixedDay(time.April, 29)},
+	{"憲法記念日", 1949, japaneseHolidayMaxYear, fixedDay(time.May, 3)},
+	{"みどりの日", 2007, japaneseHolidayMaxYear, fixedDay(time.May, 4)},
+	{"こどもの日", 1949, japaneseHolidayMaxYear, fixedDay(time.May, 5)},
+	{"海の日", 1996, 2002, fixedDay(time.July, 20)},
+	{"海の日", 2003, 2019, nthMonday(time.July, 3)},
+	{"海の日", 2020, 2020, fixedDay(time.July, 23)},
+	{"海の日", 2021, 2021, fixedDay(time.July, 22)},
+	{"海の日", 2022, japaneseHolidayMaxYear, nthMonday(time.July, 3)},
+	{"山の日", 2016, 2019, fixedDay(time.August, 11)},
+	{"山の日", 2020, 2020, fixedDay(time.August, 10)},
+	{"山の日", 2021, 2021, fixedDay(time.August, 8)},
+	{"山の日", 2022, japaneseHolidayMaxYear, fixedDay(time.August, 11)},
+	{"敬老の日", 1966, 2002, fixedDay(time.September, 15)},
+	{"敬老の日", 2003, japaneseHolidayMaxYear, nthMonday(time.September, 3)},
+	{"秋分の日", 1948, japaneseHolidayMaxYear, autumnalEquinoxDay},
+	{"体育の日", 1966, 1999, fixedDay(time.October, 10)},
+	{"体育の日", 2000, 2019, nthMonday(time.October, 2)},
+	{"スポーツの日", 2020, 2020, fixedDay(time.July, 24)},
+	{"スポーツの日", 2021, 2021, fixedDay(time.July, 23)},
+	{"スポーツの日", 2022, japaneseHolidayMaxYear, nthMonday(time.October, 2)},
+	{"文化の日", 1948, japaneseHolidayMaxYear, fixedDay(time.November, 3)},
+	{"勤労感謝の日", 1948, japaneseHolidayMaxYear, fixedDay(time.November, 23)},
+	{"皇太子・明仁親王の結婚の儀", 1959, 1959, fixedDay(time.April, 10)},
+	{"昭和天皇の大喪の礼", 1989, 1989, fixedDay(time.February, 24)},
+	{"即位礼正殿の儀", 1990, 1990, fixedDay(time.November, 12)},
+	{"皇太子・徳仁親王の結婚の儀", 1993, 1993, fixedDay(time.June, 9)},
+	{"天皇の即位の日", 2019, 2019, fixedDay(time.May, 1)},
+	{"即位礼正殿の儀", 2019, 2019, fixedDay(time.October, 22)},
+}
+
+// Factory functions
+// --------------------------------------------------
+
+// NewJapaneseCalendar creates a new JapaneseCalendar instance.
+func NewJapaneseCalendar() *JapaneseCalendar {
+	return &JapaneseCalendar{}
+}
+
+// Determination methods
+// --------------------------------------------------
+
+// IsHoliday checks if the specified date is a holiday in Japan.
+func (c *JapaneseCalendar) IsHoliday(date Date) bool {
+	_, ok := c.HolidayName(date)
+
+	return ok
+}
+
+// Conversion methods
+// --------------------------------------------------
+
+// HolidayName returns the Japanese name of the holiday on the specified date.
+// The second return value reports whether the date is a holiday.
+func (c *JapaneseCalendar) HolidayName(date Date) (string, bool) {
+	name, ok := c.holidays(date.Year())[monthDay{date.Month(), date.Day()}]
+
+	return name, ok
+}
+
+// HolidaysInYear returns the holidays in the specified year in ascending order.
+func (c *JapaneseCalendar) HolidaysInYear(year int) Dates {
+	holidays := c.holidays(year)
+	ds := make(Dates, 0, len(holidays))
+
+	for md := range holidays {
+		ds = append(ds, NewDate(year, md.month, md.day))
+	}
+
+	return ds.SortMutable()
+}
+
+// HolidaysInMonth returns the holidays in the specified Month in ascending order.
+func (c *JapaneseCalendar) HolidaysInMonth(month Month) Dates {
+	ds := make(Dates, 0)
+
+	for _, d := range c.HolidaysInYear(month.Year()) {
+		if d.Month() == month.Month() {
+			ds = append(ds, d)
+		}
+	}
+
+	return ds
+}
+
+// holidays returns the holidays in the specified year, computing and caching them on first use.
+func (c *JapaneseCalendar) holidays(year int) map[monthDay]string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if holidays, ok := c.years[year]; ok {
+		return holidays
+	}
+
+	if c.years == nil {
+		c.years = make(map[int]map[monthDay]string)
+	}
+
+	holidays := japaneseHolidaysOf(year)
+	c.years[year] = holidays
+
+	return holidays
+}
+
+// japaneseHolidaysOf computes the holidays in the specified year.
+func japaneseHolidaysOf(year int) map[monthDay]string {
+	holidays := make(map[monthDay]string)
+	if year < japaneseHolidayLawYear {
+		return holidays
+	}
+
+	national := make(map[monthDay]bool)
+	for _, rule := range japaneseHolidayRules {
+		if year < rule.from || year > rule.to {
+			continue
+		}
+
+		if month, day, ok := rule.day(year); ok {
+			md := monthDay{month, day}
+			holidays[md] = rule.name
+			national[md] = true
+		}
+	}
+
+	substitutes := make(map[monthDay]bool)
+	for md := range national {
+		date := time.Date(year, md.month, md.day, 0, 0, 0, 0, time.UTC)
+		if date.Weekday() != time.Sunday || date.Before(substituteHolidayStart) {
+			continue
+		}
+
+		next := date.AddDate(0, 0, 1)
+		if year >= 2007 {
+			for national[monthDayOfTime(next)] {
+				next = next.AddDate(0, 0, 1)
+			}
+		} else if national[monthDayOfTime(next)] {
+			continue
+		}
+
+		if next.Year() == year {
+			holidays[monthDayOfTime(next)] = "振替休日"
+			substitutes[monthDayOfTime(next)] = true
+		}
+	}
+
+	for d := time.Date(year, time.January, 2, 0, 0, 0, 0, time.UTC); d.Year() == year; d = d.AddDate(0, 0, 1) {
+		md := monthDayOfTime(d)
+		if national[md] || d.Before(citizensHolidayStart) {
+			continue
+		}
+
+		if !national[monthDayOfTime(d.AddDate(0, 0, -1))] || !national[monthDayOfTime(d.AddDate(0, 0, 1))] {
+			continue
+		}
+
+		if year < 2007 && (d.Weekday() == time.Sunday || substitutes[md]) {
+			continue
+		}
+
+		holidays[md] = "国民の休日"
+	}
+
+	return holidays
+}
+
+// monthDayOfTime returns the monthDay of the specified time.
+func monthDayOfTime(t time.Time) monthDay {
+	return monthDay{t.Month(), t.Day()}
+}
+
+// fixedDay returns a rule for a holiday on a fixed date.
+func fixedDay(month time.Month, day int) func(int) (time.Month, int, bool) {
+	return func(int) (time.Month, int, bool) {
+		return month, day, true
+	}
+}
+
+// nthMonday returns a rule for a holiday on the nth Monday of the month (Happy Monday).
+func nthMonday(month time.Month, n int) func(int) (time.Month, int, bool) {
+	return func(year int) (time.Month, int, bool) {
+		first := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC).Weekday()
+		offset := (int(time.Monday) - int(first) + 7) % 7
+
+		return month, 1 + offset + (n-1)*7, true
+	}
+}
+
+// vernalEquinoxDay returns the day of the vernal equinox in March.
+func vernalEquinoxDay(year int) (time.Month, int, bool) {
+	day, ok := equinoxDay(year, 20.8357, 20.8431, 21.8510)
+
+	return time.March, day, ok
+}
+
+// autumnalEquinoxDay returns the day of the autumnal equinox in September.
+func autumnalEquinoxDay(year int) (time.Month, int, bool) {
+	day, ok := equinoxDay(year, 23.2588, 23.2488, 24.2488)
+
+	return time.September, day, ok
+}
+
+// equinoxDay approximates the day of an equinox with the widely used formula based on the year 1980.
+// The three constants are used for 1900-1979, 1980-2099 and 2100-2150 respectively.
+func equinoxDay(year int, c1900, c1980, c2100 float64) (int, bool) {
+	var base float64
+	var leaps int
+
+	switch {
+	case year >= 1900 && year <= 1979:
+		base, leaps = c1900, (year-1983)/4
+	case year >= 1980 && year <= 2099:
+		base, leaps = c1980, (year-1980)/4
+	case year >= 2100 && year <= 2150:
+		base, leaps = c2100, (year-1980)/4
+	default:
+		return 0, false
+	}
+
+	return int(base + 0.242194*float64(year-1980) - float64(leaps)), true
+}
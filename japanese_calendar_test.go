@@ -0,0 +1,173 @@
+package date
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewJapaneseCalendar(t *testing.T) {
+	t.Run("NewJapaneseCalendar()", func(t *testing.T) {
+		var cal NamedCalendar = NewJapaneseCalendar()
+		assert.NotNil(t, cal)
+	})
+}
+
+// Determination methods
+// --------------------------------------------------
+
+func TestJapaneseCalendarIsHoliday(t *testing.T) {
+	tests := []struct {
+		date string
+		want bool
+	}{
+		{"2024-01-01", true},
+		{"2024-01-02", false},
+		{"2024-02-12", true},
+		{"2024-06-05", false},
+		{"1948-01-01", false},
+		{"1948-11-03", true},
+	}
+
+	cal := NewJapaneseCalendar()
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`JapaneseCalendar.IsHoliday(Date{"%s"})`, tt.date)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, cal.IsHoliday(MustParse(tt.date)))
+		})
+	}
+}
+
+// Conversion methods
+// --------------------------------------------------
+
+func TestJapaneseCalendarHolidayName(t *testing.T) {
+	tests := []struct {
+		date string
+		want string
+	}{
+		// Substitute holidays
+		{"1973-04-30", "振替休日"},
+		{"1973-02-12", ""},
+		{"2008-05-06", "振替休日"},
+		{"2009-05-06", "振替休日"},
+		{"2021-08-09", "振替休日"},
+		// Citizens' holidays
+		{"1985-05-04", ""},
+		{"1988-05-04", "国民の休日"},
+		{"1986-05-04", ""},
+		{"1992-05-04", "振替休日"},
+		{"2009-09-22", "国民の休日"},
+		{"2019-04-30", "国民の休日"},
+		{"2019-05-02", "国民の休日"},
+		{"2026-09-22", "国民の休日"},
+		// Happy Monday
+		{"1999-01-15", "成人の日"},
+		{"2000-01-10", "成人の日"},
+		{"2003-07-21", "海の日"},
+		{"2003-09-15", "敬老の日"},
+		// Equinoxes
+		{"2024-03-20", "春分の日"},
+		{"2024-09-22", "秋分の日"},
+		{"2025-09-23", "秋分の日"},
+		{"1960-03-20", "春分の日"},
+		// Renamed and moved holidays
+		{"1988-04-29", "天皇誕生日"},
+		{"1989-04-29", "みどりの日"},
+		{"2007-04-29", "昭和の日"},
+		{"2018-12-23", "天皇誕生日"},
+		{"2019-12-23", ""},
+		{"2020-02-23", "天皇誕生日"},
+		// Olympic moves
+		{"2020-07-20", ""},
+		{"2020-07-23", "海の日"},
+		{"2020-07-24", "スポーツの日"},
+		{"2020-08-10", "山の日"},
+		{"2020-10-12", ""},
+		{"2021-07-22", "海の日"},
+		{"2021-07-23", "スポーツの日"},
+		{"2021-08-08", "山の日"},
+		// One-off holidays
+		{"1989-02-24", "昭和天皇の大喪の礼"},
+		{"2019-05-01", "天皇の即位の日"},
+		{"2019-10-22", "即位礼正殿の儀"},
+	}
+
+	cal := NewJapaneseCalendar()
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`JapaneseCalendar.HolidayName(Date{"%s"})`, tt.date)
+
+		t.Run(testcase, func(t *testing.T) {
+			name, ok := cal.HolidayName(MustParse(tt.date))
+
+			assert.Equal(t, tt.want != "", ok)
+			assert.Equal(t, tt.want, name)
+		})
+	}
+}
+
+func TestJapaneseCalendarHolidaysInYear(t *testing.T) {
+	t.Run("JapaneseCalendar.HolidaysInYear(2024)", func(t *testing.T) {
+		want := []string{
+			"2024-01-01", "2024-01-08", "2024-02-11", "2024-02-12", "2024-02-23",
+			"2024-03-20", "2024-04-29", "2024-05-03", "2024-05-04", "2024-05-05",
+			"2024-05-06", "2024-07-15", "2024-08-11", "2024-08-12", "2024-09-16",
+			"2024-09-22", "2024-09-23", "2024-10-14", "2024-11-03", "2024-11-04",
+			"2024-11-23",
+		}
+
+		assert.Equal(t, want, NewJapaneseCalendar().HolidaysInYear(2024).Strings())
+	})
+
+	t.Run("JapaneseCalendar.HolidaysInYear(1947)", func(t *testing.T) {
+		assert.Empty(t, NewJapaneseCalendar().HolidaysInYear(1947))
+	})
+}
+
+func TestJapaneseCalendarHolidaysInMonth(t *testing.T) {
+	tests := []struct {
+		month Month
+		want  []string
+	}{
+		{NewMonth(2024, time.May), []string{"2024-05-03", "2024-05-04", "2024-05-05", "2024-05-06"}},
+		{NewMonth(2024, time.June), []string{}},
+	}
+
+	cal := NewJapaneseCalendar()
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`JapaneseCalendar.HolidaysInMonth(Month{"%s"})`, tt.month)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, cal.HolidaysInMonth(tt.month).Strings())
+		})
+	}
+}
+
+func TestDateHolidayName(t *testing.T) {
+	tests := []struct {
+		date Date
+		cal  NamedCalendar
+		want string
+	}{
+		{MustParse("2024-01-01"), NewJapaneseCalendar(), "元日"},
+		{MustParse("2024-01-02"), NewJapaneseCalendar(), ""},
+		{MustParse("2024-01-01"), nil, ""},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Date{"%s"}.HolidayName(%v)`, tt.date, tt.cal != nil)
+
+		t.Run(testcase, func(t *testing.T) {
+			name, ok := tt.date.HolidayName(tt.cal)
+
+			assert.Equal(t, tt.want != "", ok)
+			assert.Equal(t, tt.want, name)
+		})
+	}
+}
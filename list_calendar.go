@@ -0,0 +1,90 @@
+package date
+
+// CalendarEntry is a named DateRange in a ListCalendar, such as a holiday or a company closure.
+type CalendarEntry struct {
+	name   string
+	period DateRange
+}
+
+// NewCalendarEntry creates a new CalendarEntry instance with the specified name and DateRange.
+func NewCalendarEntry(name string, period DateRange) CalendarEntry {
+	return CalendarEntry{
+		name:   name,
+		period: period,
+	}
+}
+
+// Name returns the name of the CalendarEntry instance.
+func (e CalendarEntry) Name() string {
+	return e.name
+}
+
+// DateRange returns the DateRange of the CalendarEntry instance.
+func (e CalendarEntry) DateRange() DateRange {
+	return e.period
+}
+
+// ListCalendar is a NamedCalendar built from an explicit list of CalendarEntry instances.
+type ListCalendar struct {
+	entries  []CalendarEntry
+	holidays map[civilDate]string
+}
+
+// Factory functions
+// --------------------------------------------------
+
+// NewListCalendar creates a new ListCalendar instance with the specified entries.
+// When entries overlap, the name of the earlier entry in the list is used.
+func NewListCalendar(entries ...CalendarEntry) *ListCalendar {
+	holidays := make(map[civilDate]string)
+
+	for _, e := range entries {
+		for d := e.period.start; d.BeforeOrEqual(e.period.end); d = d.AddDay() {
+			if _, ok := holidays[civilDateOf(d)]; !ok {
+				holidays[civilDateOf(d)] = e.name
+			}
+		}
+	}
+
+	return &ListCalendar{
+		entries:  append([]CalendarEntry{}, entries...),
+		holidays: holidays,
+	}
+}
+
+// Determination methods
+// --------------------------------------------------
+
+// IsHoliday checks if the specified date is within any entry of the ListCalendar instance.
+func (c *ListCalendar) IsHoliday(date Date) bool {
+	_, ok := c.HolidayName(date)
+
+	return ok
+}
+
+// Conversion methods
+// --------------------------------------------------
+
+// HolidayName returns the name of the entry containing the specified date.
+// The second return value reports whether the date is a holiday.
+func (c *ListCalendar) HolidayName(date Date) (string, bool) {
+	name, ok := c.holidays[civilDateOf(date)]
+
+	return name, ok
+}
+
+// Entries returns the entries of the ListCalendar instance.
+func (c *ListCalendar) Entries() []CalendarEntry {
+	return append([]CalendarEntry{}, c.entries...)
+}
+
+// Dates returns all dates covered by the entries of the ListCalendar instance in ascending order.
+func (c *ListCalendar) Dates() Dates {
+	ds := make(Dates, 0, len(c.holidays))
+
+	for key := range c.holidays {
+		ds = append(ds, NewDate(key.year, key.month, key.day))
+	}
+
+	return ds.SortMutable()
+}
@@ -0,0 +1,93 @@
+package date
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCalendarEntry(t *testing.T) {
+	t.Run("NewCalendarEntry()", func(t *testing.T) {
+		r := MustParseDateRange("2024-08-13", "2024-08-15")
+		entry := NewCalendarEntry("Summer Break", r)
+
+		assert.Equal(t, "Summer Break", entry.Name())
+		assert.Equal(t, r, entry.DateRange())
+	})
+}
+
+func TestListCalendarIsHoliday(t *testing.T) {
+	cal := NewListCalendar(
+		NewCalendarEntry("Summer Break", MustParseDateRange("2024-08-13", "2024-08-15")),
+		NewCalendarEntry("Foundation Day", MustParseDateRange("2024-10-01", "2024-10-01")),
+	)
+
+	tests := []struct {
+		date string
+		want bool
+	}{
+		{"2024-08-12", false},
+		{"2024-08-13", true},
+		{"2024-08-15", true},
+		{"2024-08-16", false},
+		{"2024-10-01", true},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`ListCalendar.IsHoliday(Date{"%s"})`, tt.date)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, cal.IsHoliday(MustParse(tt.date)))
+		})
+	}
+}
+
+func TestListCalendarHolidayName(t *testing.T) {
+	cal := NewListCalendar(
+		NewCalendarEntry("Summer Break", MustParseDateRange("2024-08-13", "2024-08-15")),
+		NewCalendarEntry("Office Move", MustParseDateRange("2024-08-15", "2024-08-16")),
+	)
+
+	tests := []struct {
+		date string
+		want string
+	}{
+		{"2024-08-13", "Summer Break"},
+		{"2024-08-15", "Summer Break"},
+		{"2024-08-16", "Office Move"},
+		{"2024-08-17", ""},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`ListCalendar.HolidayName(Date{"%s"})`, tt.date)
+
+		t.Run(testcase, func(t *testing.T) {
+			name, ok := cal.HolidayName(MustParse(tt.date))
+
+			assert.Equal(t, tt.want != "", ok)
+			assert.Equal(t, tt.want, name)
+		})
+	}
+}
+
+func TestListCalendarEntries(t *testing.T) {
+	t.Run("ListCalendar.Entries()", func(t *testing.T) {
+		entries := []CalendarEntry{
+			NewCalendarEntry("Summer Break", MustParseDateRange("2024-08-13", "2024-08-15")),
+		}
+
+		assert.Equal(t, entries, NewListCalendar(entries...).Entries())
+	})
+}
+
+func TestListCalendarDates(t *testing.T) {
+	t.Run("ListCalendar.Dates()", func(t *testing.T) {
+		cal := NewListCalendar(
+			NewCalendarEntry("Foundation Day", MustParseDateRange("2024-10-01", "2024-10-01")),
+			NewCalendarEntry("Summer Break", MustParseDateRange("2024-08-13", "2024-08-14")),
+		)
+
+		assert.Equal(t, []string{"2024-08-13", "2024-08-14", "2024-10-01"}, cal.Dates().Strings())
+	})
+}
@@ -1,13 +1,28 @@
 package date
 
 import (
+	"database/sql/driver"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 )
 
 var (
 	ErrEndMonthIsBeforeStartMonth = fmt.Errorf("end month is before start month")
+	ErrInvalidMonthValue          = fmt.Errorf("invalid month value")
+)
+
+// MonthEncoding specifies how a Month is stored in a database column.
+type MonthEncoding int
+
+const (
+	// MonthEncodingString stores a Month as a string in the format "2006-01".
+	MonthEncodingString MonthEncoding = iota
+	// MonthEncodingDate stores a Month as the first date of the month, for DATE columns.
+	MonthEncodingDate
+	// MonthEncodingInt stores a Month as an integer in the format YYYYMM, such as 202403.
+	MonthEncodingInt
 )
 
 type Month struct {
@@ -146,27 +161,52 @@ func (m Month) IsDecember() bool {
 
 // IsPast checks if the Month instance is in the past.
 func (m Month) IsPast() bool {
-	return m.Before(CurrentMonth())
+	return m.IsPastWith(DefaultClock())
+}
+
+// IsPastWith checks if the Month instance is in the past according to the specified Clock.
+func (m Month) IsPastWith(c Clock) bool {
+	return m.Before(c.CurrentMonth())
 }
 
 // IsFuture checks if the Month instance is in the future.
 func (m Month) IsFuture() bool {
-	return m.After(CurrentMonth())
+	return m.IsFutureWith(DefaultClock())
+}
+
+// IsFutureWith checks if the Month instance is in the future according to the specified Clock.
+func (m Month) IsFutureWith(c Clock) bool {
+	return m.After(c.CurrentMonth())
 }
 
 // IsCurrentMonth checks if the Month instance is the current month.
 func (m Month) IsCurrentMonth() bool {
-	return m.Equal(CurrentMonth())
+	return m.IsCurrentMonthWith(DefaultClock())
+}
+
+// IsCurrentMonthWith checks if the Month instance is the current month according to the specified Clock.
+func (m Month) IsCurrentMonthWith(c Clock) bool {
+	return m.Equal(c.CurrentMonth())
 }
 
 // IsNextMonth checks if the Month instance is the next month.
 func (m Month) IsNextMonth() bool {
-	return m.Equal(NextMonth())
+	return m.IsNextMonthWith(DefaultClock())
+}
+
+// IsNextMonthWith checks if the Month instance is the next month according to the specified Clock.
+func (m Month) IsNextMonthWith(c Clock) bool {
+	return m.Equal(c.CurrentMonth().AddMonth())
 }
 
 // IsLastMonth checks if the Month instance is the previous month.
 func (m Month) IsLastMonth() bool {
-	return m.Equal(LastMonth())
+	return m.IsLastMonthWith(DefaultClock())
+}
+
+// IsLastMonthWith checks if the Month instance is the previous month according to the specified Clock.
+func (m Month) IsLastMonthWith(c Clock) bool {
+	return m.Equal(c.CurrentMonth().SubMonth())
 }
 
 // Comparison methods
@@ -320,6 +360,11 @@ func (m Month) ToDateRange() DateRange {
 	return r
 }
 
+// ToQuarter converts the Month instance to a Quarter instance.
+func (m Month) ToQuarter() Quarter {
+	return QuarterFromMonth(m)
+}
+
 // Days returns the number of days in the Month instance.
 func (m Month) Days() int {
 	return m.ToDateRange().Days()
@@ -353,6 +398,81 @@ func (m Month) String() string {
 // Marshalling methods
 // --------------------------------------------------
 
+// Value returns the driver.Value representation of the Month instance in the format "2006-01".
+// Use Encode to store it in another encoding.
+func (m Month) Value() (driver.Value, error) {
+	return m.String(), nil
+}
+
+// Encode returns a driver.Valuer storing the Month instance in the specified encoding.
+func (m Month) Encode(encoding MonthEncoding) MonthValuer {
+	return MonthValuer{m, encoding}
+}
+
+// Scan scans a value into the Month instance.
+// It accepts strings in the format "2006-01", dates and time.Time values in the month,
+// and integers in the format YYYYMM, so that it can read any MonthEncoding.
+func (m *Month) Scan(value interface{}) error {
+	switch value := value.(type) {
+	case nil:
+		return nil
+
+	case time.Time:
+		if value.IsZero() {
+			return fmt.Errorf("Month.Scan: value is zero value of time.Time")
+		}
+
+		*m = MonthFromTime(value)
+
+	case int64:
+		year, month := value/100, value%100
+		if month < 1 || month > 12 {
+			return fmt.Errorf("Month.Scan: %d: %w", value, ErrInvalidMonthValue)
+		}
+
+		*m = NewMonth(int(year), time.Month(month))
+
+	case string:
+		if value == "" {
+			return nil
+		}
+
+		if i, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return m.Scan(i)
+		}
+
+		if len(value) == len("2006-01-02") {
+			d, err := Parse(value)
+			if err != nil {
+				return fmt.Errorf("Month.Scan: %w", err)
+			}
+
+			*m = MonthFromDate(d)
+
+			return nil
+		}
+
+		month, err := ParseMonth(value)
+		if err != nil {
+			return fmt.Errorf("Month.Scan: %w", err)
+		}
+
+		*m = month
+
+	case []byte:
+		if len(value) == 0 {
+			return nil
+		}
+
+		return m.Scan(string(value))
+
+	default:
+		return fmt.Errorf("Month.Scan: unsupported type %T: %w", value, ErrInvalidMonthValue)
+	}
+
+	return nil
+}
+
 // MarshalText marshals the Month instance to a text representation.
 func (m *Month) MarshalText() ([]byte, error) {
 	return []byte(m.String()), nil
@@ -388,3 +508,21 @@ func (m *Month) UnmarshalJSON(json []byte) error {
 
 	return nil
 }
+
+// MonthValuer is a driver.Valuer storing a Month in a MonthEncoding, created by Month.Encode.
+type MonthValuer struct {
+	month    Month
+	encoding MonthEncoding
+}
+
+// Value returns the driver.Value representation of the Month in the MonthEncoding.
+func (v MonthValuer) Value() (driver.Value, error) {
+	switch v.encoding {
+	case MonthEncodingDate:
+		return v.month.FirstDate().Value()
+	case MonthEncodingInt:
+		return int64(v.month.Year()*100 + int(v.month.Month())), nil
+	}
+
+	return v.month.Value()
+}
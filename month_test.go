@@ -1,6 +1,7 @@
 package date
 
 import (
+	"database/sql/driver"
 	"fmt"
 	"testing"
 	"time"
@@ -1524,6 +1525,89 @@ func TestMonthString(t *testing.T) {
 // Marshalling methods
 // --------------------------------------------------
 
+func TestMonthValue(t *testing.T) {
+	tests := []struct {
+		month     Month
+		encoding  MonthEncoding
+		wantValue driver.Value
+	}{
+		{MustParseMonth("2024-03"), MonthEncodingString, "2024-03"},
+		{MustParseMonth("2024-03"), MonthEncodingDate, "2024-03-01"},
+		{MustParseMonth("2024-03"), MonthEncodingInt, int64(202403)},
+		{MustParseMonth("2024-12"), MonthEncodingInt, int64(202412)},
+		{ZeroMonth(), MonthEncodingString, "0001-01"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Month{"%s"}.Encode(%d).Value()`, tt.month, tt.encoding)
+
+		t.Run(testcase, func(t *testing.T) {
+			value, err := tt.month.Encode(tt.encoding).Value()
+			assert.Nil(t, err, "Expected no error, got %v", err)
+			assert.Equal(t, tt.wantValue, value)
+		})
+	}
+
+	t.Run(`Month{"2024-03"}.Value()`, func(t *testing.T) {
+		value, err := MustParseMonth("2024-03").Value()
+		assert.Nil(t, err, "Expected no error, got %v", err)
+		assert.Equal(t, "2024-03", value)
+	})
+}
+
+func TestMonthScan(t *testing.T) {
+	tests := []struct {
+		value interface{}
+		want  string
+	}{
+		{"2024-03", "2024-03"},
+		{[]byte("2024-03"), "2024-03"},
+		{"2024-03-01", "2024-03"},
+		{"2024-03-15", "2024-03"},
+		{time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), "2024-03"},
+		{int64(202403), "2024-03"},
+		{"202412", "2024-12"},
+		{[]byte("202403"), "2024-03"},
+		{nil, "0001-01"},
+		{"", "0001-01"},
+		{int64(202413), "error"},
+		{int64(202400), "error"},
+		{"2024-13", "error"},
+		{"2024-03-32", "error"},
+		{time.Time{}, "error"},
+		{3.14, "error"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Month{}.Scan(%#v)`, tt.value)
+
+		t.Run(testcase, func(t *testing.T) {
+			m := ZeroMonth()
+			err := m.Scan(tt.value)
+			if tt.want == "error" {
+				assert.Error(t, err)
+				assert.True(t, m.IsZero(), "month is not zero")
+			} else {
+				assert.NoError(t, err, "Expected no error, got %v", err)
+				assert.Equal(t, tt.want, m.String())
+			}
+		})
+	}
+}
+
+func TestMonthScanRoundTrip(t *testing.T) {
+	for _, encoding := range []MonthEncoding{MonthEncodingString, MonthEncodingDate, MonthEncodingInt} {
+		t.Run(fmt.Sprintf("MonthEncoding(%d)", encoding), func(t *testing.T) {
+			value, err := MustParseMonth("2024-03").Encode(encoding).Value()
+			assert.Nil(t, err, "Expected no error, got %v", err)
+
+			var m Month
+			assert.Nil(t, m.Scan(value))
+			assert.Equal(t, "2024-03", m.String())
+		})
+	}
+}
+
 func TestMonthMarshalText(t *testing.T) {
 	tests := []struct {
 		month Month
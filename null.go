@@ -40,6 +40,11 @@ func NullDateForNull() NullDate {
 	return NullDate{}
 }
 
+// NullDateFromNull creates a NullDate instance from a generic Null[Date] instance.
+func NullDateFromNull(n Null[Date]) NullDate {
+	return NullDate{date: n.value, isNotNull: n.isNotNull}
+}
+
 // Determination methods
 // --------------------------------------------------
 
@@ -57,9 +62,10 @@ func (nd NullDate) IsNotNull() bool {
 // --------------------------------------------------
 
 // Equal checks if the NullDate instance is equal to another NullDate instance.
+// Two null instances are equal, and a null instance is not equal to any date.
 func (nd NullDate) Equal(target NullDate) bool {
-	if nd.IsNull() {
-		return nd.isNotNull == target.isNotNull
+	if nd.IsNull() || target.IsNull() {
+		return nd.IsNull() == target.IsNull()
 	}
 
 	return nd.date.Equal(target.date)
@@ -128,6 +134,16 @@ func (nd NullDate) StringPtr() *string {
 	return nd.date.StringPtr()
 }
 
+// ToNull converts the NullDate instance to a generic Null[Date] instance.
+func (nd NullDate) ToNull() Null[Date] {
+	return Null[Date]{value: nd.date, isNotNull: nd.isNotNull}
+}
+
+// ToNullMonth converts the NullDate instance to a NullMonth instance of the month containing the date.
+func (nd NullDate) ToNullMonth() NullMonth {
+	return MapNull(nd.ToNull(), Date.ToMonth)
+}
+
 // Conditional methods
 // --------------------------------------------------
 
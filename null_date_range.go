@@ -0,0 +1,23 @@
+package date
+
+// NullDateRange is a nullable DateRange.
+// It is an alias of Null[DateRange], so it has all the methods of Null.
+type NullDateRange = Null[DateRange]
+
+// Factory functions
+// --------------------------------------------------
+
+// NullDateRangeFromDateRange creates a NullDateRange instance from a DateRange instance.
+func NullDateRangeFromDateRange(dateRange DateRange) NullDateRange {
+	return NullFrom(dateRange)
+}
+
+// NullDateRangeFromDateRangePtr creates a NullDateRange instance from a pointer to a DateRange instance.
+func NullDateRangeFromDateRangePtr(dateRange *DateRange) NullDateRange {
+	return NullFromPtr(dateRange)
+}
+
+// NullDateRangeForNull returns a NullDateRange instance representing a null value.
+func NullDateRangeForNull() NullDateRange {
+	return NullForNull[DateRange]()
+}
@@ -0,0 +1,102 @@
+package date
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Marshalling methods
+// --------------------------------------------------
+
+func TestNullDateRangeValue(t *testing.T) {
+	tests := []struct {
+		ndr  NullDateRange
+		want driver.Value
+	}{
+		{NullDateRangeFromDateRange(MustParseDateRange("2024-06-05", "2024-06-30")), "[2024-06-05,2024-07-01)"},
+		{NullDateRangeFromDateRange(ZeroDateRange()), "empty"},
+		{NullDateRangeForNull(), nil},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`NullDateRange{"%s"}.Value()`, tt.ndr)
+
+		t.Run(testcase, func(t *testing.T) {
+			value, err := tt.ndr.Value()
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, value)
+		})
+	}
+}
+
+func TestNullDateRangeScan(t *testing.T) {
+	tests := []struct {
+		value   interface{}
+		want    NullDateRange
+		wantErr error
+	}{
+		{"[2024-06-05,2024-07-01)", NullDateRangeFromDateRange(MustParseDateRange("2024-06-05", "2024-06-30")), nil},
+		{[]byte("(2024-06-04,2024-06-30]"), NullDateRangeFromDateRange(MustParseDateRange("2024-06-05", "2024-06-30")), nil},
+		{"empty", NullDateRangeFromDateRange(ZeroDateRange()), nil},
+		{nil, NullDateRangeForNull(), nil},
+		{"[2024-06-05,)", NullDateRangeForNull(), ErrDateRangeIsUnbounded},
+		{"2024-06-05/2024-06-30", NullDateRangeForNull(), ErrInvalidRangeLiteral},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`NullDateRange.Scan(%#v)`, tt.value)
+
+		t.Run(testcase, func(t *testing.T) {
+			ndr := NullDateRangeFromDateRange(MustParseDateRange("2000-01-01", "2000-01-31"))
+			err := ndr.Scan(tt.value)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.True(t, tt.want.Equal(ndr), "got %s", ndr)
+		})
+	}
+}
+
+func TestNullDateRangeText(t *testing.T) {
+	for _, text := range []string{"2024-06-05/2024-06-30", "null"} {
+		t.Run(text, func(t *testing.T) {
+			var ndr NullDateRange
+			assert.NoError(t, ndr.UnmarshalText([]byte(text)))
+
+			got, err := ndr.MarshalText()
+			assert.NoError(t, err)
+			assert.Equal(t, text, string(got))
+		})
+	}
+
+	var ndr NullDateRange
+	assert.NoError(t, ndr.UnmarshalText([]byte("2024-06-05/P1M")))
+	assert.True(t, NullDateRangeFromDateRange(MustParseDateRange("2024-06-05", "2024-07-04")).Equal(ndr))
+
+	assert.Error(t, ndr.UnmarshalText([]byte("2024-06-30/2024-06-05")))
+	assert.True(t, ndr.IsNull())
+}
+
+func TestNullDateRangeJSON(t *testing.T) {
+	for _, json := range []string{`{"start":"2024-06-05","end":"2024-06-30"}`, "null"} {
+		t.Run(json, func(t *testing.T) {
+			var ndr NullDateRange
+			assert.NoError(t, ndr.UnmarshalJSON([]byte(json)))
+
+			got, err := ndr.MarshalJSON()
+			assert.NoError(t, err)
+			assert.Equal(t, json, string(got))
+		})
+	}
+
+	var ndr NullDateRange
+	assert.Error(t, ndr.UnmarshalJSON([]byte(`"2024-06-05/2024-06-30"`)))
+	assert.True(t, ndr.IsNull())
+}
@@ -0,0 +1,23 @@
+package date
+
+// NullMonth is a nullable Month.
+// It is an alias of Null[Month], so it has all the methods of Null.
+type NullMonth = Null[Month]
+
+// Factory functions
+// --------------------------------------------------
+
+// NullMonthFromMonth creates a NullMonth instance from a Month instance.
+func NullMonthFromMonth(month Month) NullMonth {
+	return NullFrom(month)
+}
+
+// NullMonthFromMonthPtr creates a NullMonth instance from a pointer to a Month instance.
+func NullMonthFromMonthPtr(month *Month) NullMonth {
+	return NullFromPtr(month)
+}
+
+// NullMonthForNull returns a NullMonth instance representing a null value.
+func NullMonthForNull() NullMonth {
+	return NullForNull[Month]()
+}
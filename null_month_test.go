@@ -0,0 +1,102 @@
+package date
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Marshalling methods
+// --------------------------------------------------
+
+func TestNullMonthValue(t *testing.T) {
+	tests := []struct {
+		nm   NullMonth
+		want driver.Value
+	}{
+		{NullMonthFromMonth(MustParseMonth("2024-06")), "2024-06"},
+		{NullMonthForNull(), nil},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`NullMonth{"%s"}.Value()`, tt.nm)
+
+		t.Run(testcase, func(t *testing.T) {
+			value, err := tt.nm.Value()
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, value)
+		})
+	}
+}
+
+func TestNullMonthScan(t *testing.T) {
+	tests := []struct {
+		value   interface{}
+		want    NullMonth
+		wantErr bool
+	}{
+		{"2024-06", NullMonthFromMonth(MustParseMonth("2024-06")), false},
+		{[]byte("2024-06"), NullMonthFromMonth(MustParseMonth("2024-06")), false},
+		{"2024-06-15", NullMonthFromMonth(MustParseMonth("2024-06")), false},
+		{int64(202406), NullMonthFromMonth(MustParseMonth("2024-06")), false},
+		{"202406", NullMonthFromMonth(MustParseMonth("2024-06")), false},
+		{time.Date(2024, time.June, 15, 0, 0, 0, 0, time.UTC), NullMonthFromMonth(MustParseMonth("2024-06")), false},
+		{nil, NullMonthForNull(), false},
+		{int64(202413), NullMonthForNull(), true},
+		{"invalid", NullMonthForNull(), true},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`NullMonth.Scan(%#v)`, tt.value)
+
+		t.Run(testcase, func(t *testing.T) {
+			nm := NullMonthFromMonth(MustParseMonth("2000-01"))
+			err := nm.Scan(tt.value)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.True(t, tt.want.Equal(nm), "got %s", nm)
+		})
+	}
+}
+
+func TestNullMonthText(t *testing.T) {
+	for _, text := range []string{"2024-06", "null"} {
+		t.Run(text, func(t *testing.T) {
+			var nm NullMonth
+			assert.NoError(t, nm.UnmarshalText([]byte(text)))
+
+			got, err := nm.MarshalText()
+			assert.NoError(t, err)
+			assert.Equal(t, text, string(got))
+		})
+	}
+
+	var nm NullMonth
+	assert.Error(t, nm.UnmarshalText([]byte("2024-13")))
+	assert.True(t, nm.IsNull())
+}
+
+func TestNullMonthJSON(t *testing.T) {
+	for _, json := range []string{`"2024-06"`, "null"} {
+		t.Run(json, func(t *testing.T) {
+			var nm NullMonth
+			assert.NoError(t, nm.UnmarshalJSON([]byte(json)))
+
+			got, err := nm.MarshalJSON()
+			assert.NoError(t, err)
+			assert.Equal(t, json, string(got))
+		})
+	}
+
+	var nm NullMonth
+	assert.Error(t, nm.UnmarshalJSON([]byte(`"2024-06-05"`)))
+	assert.True(t, nm.IsNull())
+}
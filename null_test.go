@@ -138,6 +138,11 @@ func TestNullDateEqual(t *testing.T) {
 			NullDate{},
 			false,
 		},
+		{
+			NullDateFromDate(ZeroDate()),
+			NullDate{},
+			false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,319 @@
+package date
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"fmt"
+)
+
+// Nullable is the set of value types that can be wrapped by Null.
+type Nullable interface {
+	Date | Month | DateRange | OpenDateRange
+
+	String() string
+}
+
+// Null is a generic nullable wrapper for the value types of this package.
+// Marshalling is delegated to the wrapped type, so Null[Date] behaves the same as NullDate.
+type Null[T Nullable] struct {
+	value     T
+	isNotNull bool
+}
+
+var (
+	ErrNullIsNull = fmt.Errorf("Null is null")
+)
+
+// Factory functions
+// --------------------------------------------------
+
+// NullFrom creates a Null instance holding the specified value.
+func NullFrom[T Nullable](value T) Null[T] {
+	return Null[T]{
+		value:     value,
+		isNotNull: true,
+	}
+}
+
+// NullFromPtr creates a Null instance from a pointer to a value.
+func NullFromPtr[T Nullable](value *T) Null[T] {
+	if value == nil {
+		return Null[T]{}
+	}
+
+	return NullFrom(*value)
+}
+
+// NullForNull returns a Null instance representing a null value.
+func NullForNull[T Nullable]() Null[T] {
+	return Null[T]{}
+}
+
+// MapNull applies the specified function to the value if the Null instance is not null and returns a Null instance of the result type.
+// It allows conversions between types, such as from Null[Date] to Null[Month] with MapNull(n, Date.ToMonth).
+func MapNull[T, U Nullable](n Null[T], f func(T) U) Null[U] {
+	if n.IsNull() {
+		return Null[U]{}
+	}
+
+	return NullFrom(f(n.value))
+}
+
+// FlatMapNull applies the specified function to the value if the Null instance is not null and returns its result.
+func FlatMapNull[T, U Nullable](n Null[T], f func(T) Null[U]) Null[U] {
+	if n.IsNull() {
+		return Null[U]{}
+	}
+
+	return f(n.value)
+}
+
+// Determination methods
+// --------------------------------------------------
+
+// IsNull checks if the Null instance is null.
+func (n Null[T]) IsNull() bool {
+	return !n.isNotNull
+}
+
+// IsNotNull checks if the Null instance is not null.
+func (n Null[T]) IsNotNull() bool {
+	return n.isNotNull
+}
+
+// Comparison methods
+// --------------------------------------------------
+
+// Equal checks if the Null instance is equal to another Null instance.
+// Two null instances are equal, and values are compared with the Equal method of T.
+func (n Null[T]) Equal(target Null[T]) bool {
+	if n.IsNull() || target.IsNull() {
+		return n.IsNull() == target.IsNull()
+	}
+
+	return any(n.value).(interface{ Equal(T) bool }).Equal(target.value)
+}
+
+// NotEqual checks if the Null instance is not equal to another Null instance.
+func (n Null[T]) NotEqual(target Null[T]) bool {
+	return !n.Equal(target)
+}
+
+// Conversion methods
+// --------------------------------------------------
+
+// Ptr returns a pointer to the value if the Null instance is not null, otherwise it returns nil.
+func (n Null[T]) Ptr() *T {
+	if n.IsNull() {
+		return nil
+	}
+
+	return &n.value
+}
+
+// Take returns the value if the Null instance is not null, otherwise it returns an error.
+func (n Null[T]) Take() (T, error) {
+	if n.IsNull() {
+		return n.value, fmt.Errorf("Take: %w", ErrNullIsNull)
+	}
+
+	return n.value, nil
+}
+
+// TakeOr returns the value if the Null instance is not null, otherwise it returns the specified fallback value.
+func (n Null[T]) TakeOr(fallback T) T {
+	if n.IsNull() {
+		return fallback
+	}
+
+	return n.value
+}
+
+// MustTake returns the value if the Null instance is not null, otherwise it panics.
+func (n Null[T]) MustTake() T {
+	v, err := n.Take()
+	if err != nil {
+		panic(err)
+	}
+
+	return v
+}
+
+// Or returns the Null instance if it is not null, otherwise it returns the specified fallback Null instance.
+func (n Null[T]) Or(fallback Null[T]) Null[T] {
+	if n.IsNull() {
+		return fallback
+	}
+
+	return n
+}
+
+// String returns the string representation of the Null instance.
+func (n Null[T]) String() string {
+	if n.IsNull() {
+		return "null"
+	}
+
+	return n.value.String()
+}
+
+// StringPtr returns a pointer to the string representation of the Null instance if it is not null, otherwise it returns nil.
+func (n Null[T]) StringPtr() *string {
+	if n.IsNull() {
+		return nil
+	}
+
+	s := n.value.String()
+
+	return &s
+}
+
+// Conditional methods
+// --------------------------------------------------
+
+// IfSome executes the specified function if the Null instance is not null.
+func (n Null[T]) IfSome(f func(T)) {
+	if n.IsNotNull() {
+		f(n.value)
+	}
+}
+
+// IfSomeWithError executes the specified function if the Null instance is not null and returns any error from the function.
+func (n Null[T]) IfSomeWithError(f func(T) error) error {
+	if n.IsNotNull() {
+		return f(n.value)
+	}
+
+	return nil
+}
+
+// IfNone executes the specified function if the Null instance is null.
+func (n Null[T]) IfNone(f func()) {
+	if n.IsNull() {
+		f()
+	}
+}
+
+// IfNoneWithError executes the specified function if the Null instance is null and returns any error from the function.
+func (n Null[T]) IfNoneWithError(f func() error) error {
+	if n.IsNull() {
+		return f()
+	}
+
+	return nil
+}
+
+// Map applies the specified function to the value if the Null instance is not null and returns a new Null instance.
+// Use MapNull to map to a different type.
+func (n Null[T]) Map(f func(T) T) Null[T] {
+	if n.IsNotNull() {
+		return NullFrom(f(n.value))
+	}
+
+	return n
+}
+
+// FlatMap applies the specified function to the value if the Null instance is not null and returns its result.
+// Use FlatMapNull to map to a different type.
+func (n Null[T]) FlatMap(f func(T) Null[T]) Null[T] {
+	if n.IsNotNull() {
+		return f(n.value)
+	}
+
+	return n
+}
+
+// Filter returns the Null instance if it is not null and its value satisfies the specified predicate, otherwise it returns null.
+func (n Null[T]) Filter(f func(T) bool) Null[T] {
+	if n.IsNotNull() && f(n.value) {
+		return n
+	}
+
+	return Null[T]{}
+}
+
+// Marshalling methods
+// --------------------------------------------------
+
+// Value returns the driver.Value representation of the Null instance.
+func (n Null[T]) Value() (driver.Value, error) {
+	if !n.isNotNull {
+		return nil, nil
+	}
+
+	return any(n.value).(driver.Valuer).Value()
+}
+
+// Scan scans a value into the Null instance.
+func (n *Null[T]) Scan(value interface{}) error {
+	if value == nil {
+		*n = Null[T]{}
+
+		return nil
+	}
+
+	if err := any(&n.value).(sql.Scanner).Scan(value); err != nil {
+		n.isNotNull = false
+
+		return fmt.Errorf("Null.Scan: %w", err)
+	}
+
+	n.isNotNull = true
+
+	return nil
+}
+
+// MarshalText marshals the Null instance to a text representation.
+func (n Null[T]) MarshalText() ([]byte, error) {
+	if n.isNotNull {
+		return any(&n.value).(encoding.TextMarshaler).MarshalText()
+	}
+
+	return []byte("null"), nil
+}
+
+// UnmarshalText unmarshals a text representation into the Null instance.
+func (n *Null[T]) UnmarshalText(text []byte) error {
+	if string(text) == "null" {
+		*n = Null[T]{}
+
+		return nil
+	}
+
+	err := any(&n.value).(encoding.TextUnmarshaler).UnmarshalText(text)
+	n.isNotNull = err == nil
+	if err != nil {
+		return fmt.Errorf("Null.UnmarshalText: %w", err)
+	}
+
+	return nil
+}
+
+// MarshalJSON marshals the Null instance to a JSON representation.
+func (n Null[T]) MarshalJSON() ([]byte, error) {
+	if n.isNotNull {
+		return any(n.value).(json.Marshaler).MarshalJSON()
+	}
+
+	return []byte("null"), nil
+}
+
+// UnmarshalJSON unmarshals a JSON representation into the Null instance.
+func (n *Null[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*n = Null[T]{}
+
+		return nil
+	}
+
+	err := any(&n.value).(json.Unmarshaler).UnmarshalJSON(data)
+	n.isNotNull = err == nil
+	if err != nil {
+		return fmt.Errorf("Null.UnmarshalJSON: %w", err)
+	}
+
+	return nil
+}
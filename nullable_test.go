@@ -0,0 +1,364 @@
+package date
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Factory functions
+// --------------------------------------------------
+
+func TestNullFrom(t *testing.T) {
+	n := NullFrom(MustParse("2024-06-05"))
+
+	assert.Equal(t, Null[Date]{value: MustParse("2024-06-05"), isNotNull: true}, n)
+}
+
+func TestNullFromPtr(t *testing.T) {
+	tests := []struct {
+		ptr  *Month
+		want Null[Month]
+	}{
+		{
+			func() *Month { m := MustParseMonth("2024-06"); return &m }(),
+			NullFrom(MustParseMonth("2024-06")),
+		},
+		{
+			nil,
+			Null[Month]{},
+		},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`NullFromPtr(%v)`, tt.ptr)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, NullFromPtr(tt.ptr))
+		})
+	}
+}
+
+func TestNullForNull(t *testing.T) {
+	assert.Equal(t, Null[DateRange]{}, NullForNull[DateRange]())
+	assert.True(t, NullForNull[DateRange]().IsNull())
+}
+
+func TestMapNull(t *testing.T) {
+	tests := []struct {
+		n    Null[Date]
+		want Null[Month]
+	}{
+		{
+			NullFrom(MustParse("2024-06-05")),
+			NullFrom(MustParseMonth("2024-06")),
+		},
+		{
+			Null[Date]{},
+			Null[Month]{},
+		},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`MapNull(Null[Date]{"%s"}, Date.ToMonth)`, tt.n)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.True(t, tt.want.Equal(MapNull(tt.n, Date.ToMonth)))
+		})
+	}
+}
+
+func TestFlatMapNull(t *testing.T) {
+	toRange := func(m Month) Null[DateRange] {
+		if m.Month() == 2 {
+			return Null[DateRange]{}
+		}
+
+		return NullFrom(m.ToDateRange())
+	}
+
+	tests := []struct {
+		n    Null[Month]
+		want Null[DateRange]
+	}{
+		{
+			NullFrom(MustParseMonth("2024-06")),
+			NullFrom(MustParseDateRange("2024-06-01", "2024-06-30")),
+		},
+		{
+			NullFrom(MustParseMonth("2024-02")),
+			Null[DateRange]{},
+		},
+		{
+			Null[Month]{},
+			Null[DateRange]{},
+		},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`FlatMapNull(Null[Month]{"%s"})`, tt.n)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.True(t, tt.want.Equal(FlatMapNull(tt.n, toRange)))
+		})
+	}
+}
+
+// Comparison methods
+// --------------------------------------------------
+
+func TestNullEqual(t *testing.T) {
+	tests := []struct {
+		n      Null[Date]
+		target Null[Date]
+		want   bool
+	}{
+		{NullFrom(MustParse("2024-06-05")), NullFrom(MustParse("2024-06-05")), true},
+		{NullFrom(MustParse("2024-06-05")), NullFrom(MustParse("2024-06-06")), false},
+		{NullFrom(MustParse("2024-06-05")), Null[Date]{}, false},
+		{Null[Date]{}, NullFrom(MustParse("2024-06-05")), false},
+		{Null[Date]{}, Null[Date]{}, true},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Null[Date]{"%s"}.Equal(Null[Date]{"%s"})`, tt.n, tt.target)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.n.Equal(tt.target))
+			assert.Equal(t, !tt.want, tt.n.NotEqual(tt.target))
+		})
+	}
+}
+
+func TestNullEqualZeroValue(t *testing.T) {
+	assert.False(t, NullDateFromDate(ZeroDate()).Equal(NullDateForNull()), "NullDate")
+	assert.False(t, NullFrom(ZeroDate()).Equal(NullForNull[Date]()), "Null[Date]")
+	assert.False(t, NullMonthFromMonth(ZeroMonth()).Equal(NullMonthForNull()), "NullMonth")
+	assert.False(t, NullDateRangeFromDateRange(ZeroDateRange()).Equal(NullDateRangeForNull()), "NullDateRange")
+}
+
+// Conversion methods
+// --------------------------------------------------
+
+func TestNullTake(t *testing.T) {
+	m, err := NullFrom(MustParseMonth("2024-06")).Take()
+	assert.NoError(t, err)
+	assert.Equal(t, MustParseMonth("2024-06"), m)
+
+	_, err = Null[Month]{}.Take()
+	assert.ErrorIs(t, err, ErrNullIsNull)
+
+	assert.Panics(t, func() { Null[Month]{}.MustTake() })
+	assert.Equal(t, MustParseMonth("2024-01"), Null[Month]{}.TakeOr(MustParseMonth("2024-01")))
+}
+
+func TestNullPtr(t *testing.T) {
+	assert.Nil(t, Null[Date]{}.Ptr())
+	assert.Equal(t, MustParse("2024-06-05"), *NullFrom(MustParse("2024-06-05")).Ptr())
+}
+
+func TestNullOr(t *testing.T) {
+	tests := []struct {
+		n        Null[Date]
+		fallback Null[Date]
+		want     Null[Date]
+	}{
+		{NullFrom(MustParse("2024-06-05")), NullFrom(MustParse("2024-01-01")), NullFrom(MustParse("2024-06-05"))},
+		{Null[Date]{}, NullFrom(MustParse("2024-01-01")), NullFrom(MustParse("2024-01-01"))},
+		{Null[Date]{}, Null[Date]{}, Null[Date]{}},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Null[Date]{"%s"}.Or(Null[Date]{"%s"})`, tt.n, tt.fallback)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.n.Or(tt.fallback))
+		})
+	}
+}
+
+func TestNullString(t *testing.T) {
+	assert.Equal(t, "2024-06", NullFrom(MustParseMonth("2024-06")).String())
+	assert.Equal(t, "null", Null[Month]{}.String())
+	assert.Equal(t, "2024-06", *NullFrom(MustParseMonth("2024-06")).StringPtr())
+	assert.Nil(t, Null[Month]{}.StringPtr())
+}
+
+func TestNullDateToNull(t *testing.T) {
+	assert.Equal(t, NullFrom(MustParse("2024-06-05")), NullDateFromDate(MustParse("2024-06-05")).ToNull())
+	assert.Equal(t, Null[Date]{}, NullDate{}.ToNull())
+	assert.Equal(t, NullDateFromDate(MustParse("2024-06-05")), NullDateFromNull(NullFrom(MustParse("2024-06-05"))))
+	assert.Equal(t, NullMonthFromMonth(MustParseMonth("2024-06")), NullDateFromDate(MustParse("2024-06-05")).ToNullMonth())
+	assert.Equal(t, NullMonthForNull(), NullDate{}.ToNullMonth())
+}
+
+// Conditional methods
+// --------------------------------------------------
+
+func TestNullIfSomeIfNone(t *testing.T) {
+	var some, none int
+
+	NullFrom(MustParse("2024-06-05")).IfSome(func(Date) { some++ })
+	Null[Date]{}.IfSome(func(Date) { some++ })
+	NullFrom(MustParse("2024-06-05")).IfNone(func() { none++ })
+	Null[Date]{}.IfNone(func() { none++ })
+
+	assert.Equal(t, 1, some)
+	assert.Equal(t, 1, none)
+
+	err := fmt.Errorf("Returned error")
+	assert.Equal(t, err, NullFrom(MustParse("2024-06-05")).IfSomeWithError(func(Date) error { return err }))
+	assert.NoError(t, Null[Date]{}.IfSomeWithError(func(Date) error { return err }))
+	assert.Equal(t, err, Null[Date]{}.IfNoneWithError(func() error { return err }))
+	assert.NoError(t, NullFrom(MustParse("2024-06-05")).IfNoneWithError(func() error { return err }))
+}
+
+func TestNullMap(t *testing.T) {
+	assert.Equal(t, NullFrom(MustParse("2024-06-06")), NullFrom(MustParse("2024-06-05")).Map(Date.AddDay))
+	assert.Equal(t, Null[Date]{}, Null[Date]{}.Map(Date.AddDay))
+}
+
+func TestNullFlatMap(t *testing.T) {
+	weekday := func(d Date) Null[Date] {
+		if d.IsWeekend() {
+			return Null[Date]{}
+		}
+
+		return NullFrom(d)
+	}
+
+	assert.Equal(t, NullFrom(MustParse("2024-06-05")), NullFrom(MustParse("2024-06-05")).FlatMap(weekday))
+	assert.Equal(t, Null[Date]{}, NullFrom(MustParse("2024-06-08")).FlatMap(weekday))
+	assert.Equal(t, Null[Date]{}, Null[Date]{}.FlatMap(weekday))
+}
+
+func TestNullFilter(t *testing.T) {
+	isJune := func(d Date) bool { return d.Month() == 6 }
+
+	assert.Equal(t, NullFrom(MustParse("2024-06-05")), NullFrom(MustParse("2024-06-05")).Filter(isJune))
+	assert.Equal(t, Null[Date]{}, NullFrom(MustParse("2024-07-05")).Filter(isJune))
+	assert.Equal(t, Null[Date]{}, Null[Date]{}.Filter(isJune))
+}
+
+// Marshalling methods
+// --------------------------------------------------
+
+func TestNullValue(t *testing.T) {
+	tests := []struct {
+		value func() (driver.Value, error)
+		want  driver.Value
+	}{
+		{NullFrom(MustParse("2024-06-05")).Value, "2024-06-05"},
+		{NullFrom(MustParseMonth("2024-06")).Value, "2024-06"},
+		{NullFrom(MustParseDateRange("2024-06-05", "2024-06-30")).Value, "[2024-06-05,2024-07-01)"},
+		{NullFrom(DateRangeFrom(MustParse("2024-06-05"))).Value, "[2024-06-05,)"},
+		{Null[Date]{}.Value, nil},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Null.Value() == %v`, tt.want)
+
+		t.Run(testcase, func(t *testing.T) {
+			value, err := tt.value()
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, value)
+		})
+	}
+}
+
+func TestNullScan(t *testing.T) {
+	var nm Null[Month]
+
+	assert.NoError(t, nm.Scan("2024-06"))
+	assert.Equal(t, NullFrom(MustParseMonth("2024-06")), nm)
+
+	assert.NoError(t, nm.Scan(int64(202407)))
+	assert.Equal(t, NullFrom(MustParseMonth("2024-07")), nm)
+
+	assert.NoError(t, nm.Scan(nil))
+	assert.Equal(t, Null[Month]{}, nm)
+
+	var nr Null[DateRange]
+
+	assert.NoError(t, nr.Scan("[2024-06-05,2024-07-01)"))
+	assert.True(t, NullFrom(MustParseDateRange("2024-06-05", "2024-06-30")).Equal(nr))
+
+	assert.Error(t, nr.Scan("invalid"))
+	assert.True(t, nr.IsNull())
+}
+
+func TestNullMarshalText(t *testing.T) {
+	tests := []struct {
+		text func() ([]byte, error)
+		want string
+	}{
+		{NullFrom(MustParse("2024-06-05")).MarshalText, "2024-06-05"},
+		{NullFrom(MustParseMonth("2024-06")).MarshalText, "2024-06"},
+		{NullFrom(MustParseDateRange("2024-06-05", "2024-06-30")).MarshalText, "2024-06-05/2024-06-30"},
+		{Null[Month]{}.MarshalText, "null"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			text, err := tt.text()
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, string(text))
+		})
+	}
+}
+
+func TestNullUnmarshalText(t *testing.T) {
+	var nd Null[Date]
+
+	assert.NoError(t, nd.UnmarshalText([]byte("2024-06-05")))
+	assert.Equal(t, NullFrom(MustParse("2024-06-05")), nd)
+
+	assert.NoError(t, nd.UnmarshalText([]byte("null")))
+	assert.Equal(t, Null[Date]{}, nd)
+
+	assert.Error(t, nd.UnmarshalText([]byte("invalid")))
+	assert.True(t, nd.IsNull())
+
+	var nr Null[DateRange]
+
+	assert.NoError(t, nr.UnmarshalText([]byte("2024-06-01/P1M")))
+	assert.True(t, NullFrom(MustParseDateRange("2024-06-01", "2024-06-30")).Equal(nr))
+}
+
+func TestNullMarshalJSON(t *testing.T) {
+	tests := []struct {
+		json func() ([]byte, error)
+		want string
+	}{
+		{NullFrom(MustParse("2024-06-05")).MarshalJSON, `"2024-06-05"`},
+		{NullFrom(MustParseMonth("2024-06")).MarshalJSON, `"2024-06"`},
+		{NullFrom(MustParseDateRange("2024-06-05", "2024-06-30")).MarshalJSON, `{"start":"2024-06-05","end":"2024-06-30"}`},
+		{Null[Date]{}.MarshalJSON, "null"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			json, err := tt.json()
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, string(json))
+		})
+	}
+}
+
+func TestNullUnmarshalJSON(t *testing.T) {
+	var nm Null[Month]
+
+	assert.NoError(t, nm.UnmarshalJSON([]byte(`"2024-06"`)))
+	assert.Equal(t, NullFrom(MustParseMonth("2024-06")), nm)
+
+	assert.NoError(t, nm.UnmarshalJSON([]byte("null")))
+	assert.Equal(t, Null[Month]{}, nm)
+
+	assert.Error(t, nm.UnmarshalJSON([]byte(`"invalid"`)))
+	assert.True(t, nm.IsNull())
+}
@@ -0,0 +1,458 @@
+package date
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+var (
+	ErrInvalidOpenDateRange = fmt.Errorf("invalid open date range")
+	ErrDateRangeIsUnbounded = fmt.Errorf("date range is unbounded")
+)
+
+// unboundedSide is the string representation of an unbounded side of an OpenDateRange in the "start/end" format.
+const unboundedSide = ".."
+
+// OpenDateRange is a date range whose start and end may be unbounded, such as "valid from 2024-04-01 onwards".
+// A null start means the range extends infinitely into the past, and a null end means it extends infinitely into the future.
+// The range is closed unless it is created as half-open, in which case the end date is exclusive.
+// The zero value is a closed range unbounded on both sides.
+type OpenDateRange struct {
+	start    NullDate
+	last     NullDate
+	halfOpen bool
+}
+
+// Factory functions
+// --------------------------------------------------
+
+// NewOpenDateRange creates a new closed OpenDateRange instance including both start and end.
+// A null start or end means that side is unbounded.
+// It returns an error if both sides are bounded and end is before start, or their Locations differ.
+func NewOpenDateRange(start, end NullDate) (OpenDateRange, error) {
+	if err := validateOpenDateRange(start, end); err != nil {
+		return OpenDateRange{}, fmt.Errorf("NewOpenDateRange: %w", err)
+	}
+
+	return OpenDateRange{start: start, last: end}, nil
+}
+
+// MustNewOpenDateRange creates a new closed OpenDateRange instance including both start and end.
+// It panics if the creation fails.
+func MustNewOpenDateRange(start, end NullDate) OpenDateRange {
+	r, err := NewOpenDateRange(start, end)
+	if err != nil {
+		panic(err)
+	}
+
+	return r
+}
+
+// NewHalfOpenDateRange creates a new half-open OpenDateRange instance including start and excluding end.
+// A null start or end means that side is unbounded.
+// It returns an error if both sides are bounded and end is not after start, or their Locations differ.
+func NewHalfOpenDateRange(start, end NullDate) (OpenDateRange, error) {
+	if start.IsNotNull() && end.IsNotNull() && !end.date.After(start.date) {
+		return OpenDateRange{}, fmt.Errorf("NewHalfOpenDateRange: %w", ErrEndDateIsBeforeStartDate)
+	}
+
+	last := end.Map(Date.SubDay)
+	if err := validateOpenDateRange(start, last); err != nil {
+		return OpenDateRange{}, fmt.Errorf("NewHalfOpenDateRange: %w", err)
+	}
+
+	return OpenDateRange{start: start, last: last, halfOpen: true}, nil
+}
+
+// MustNewHalfOpenDateRange creates a new half-open OpenDateRange instance including start and excluding end.
+// It panics if the creation fails.
+func MustNewHalfOpenDateRange(start, end NullDate) OpenDateRange {
+	r, err := NewHalfOpenDateRange(start, end)
+	if err != nil {
+		panic(err)
+	}
+
+	return r
+}
+
+// DateRangeFrom returns an OpenDateRange instance from the specified date onwards.
+func DateRangeFrom(start Date) OpenDateRange {
+	return OpenDateRange{start: NullDateFromDate(start)}
+}
+
+// DateRangeUntil returns an OpenDateRange instance up to and including the specified date.
+func DateRangeUntil(end Date) OpenDateRange {
+	return OpenDateRange{last: NullDateFromDate(end)}
+}
+
+// UnboundedDateRange returns an OpenDateRange instance unbounded on both sides.
+func UnboundedDateRange() OpenDateRange {
+	return OpenDateRange{}
+}
+
+// ParseOpenDateRange parses a string and returns an OpenDateRange instance.
+// Closed ranges are in the format "2006-01-02/2006-01-02", where ".." stands for an unbounded side, such as "2024-04-01/..".
+// Half-open ranges are in the format "[2006-01-02,2006-01-02)", where an empty side is unbounded, such as "[2024-04-01,)".
+func ParseOpenDateRange(value string) (OpenDateRange, error) {
+	if strings.HasPrefix(value, "[") || strings.HasPrefix(value, "(") {
+		return parseHalfOpenDateRange(value)
+	}
+
+	start, end, found := strings.Cut(value, "/")
+	if !found {
+		return OpenDateRange{}, fmt.Errorf("ParseOpenDateRange: failed to parse %q: %w", value, ErrInvalidOpenDateRange)
+	}
+
+	s, err := parseOpenDateRangeSide(start, unboundedSide)
+	if err != nil {
+		return OpenDateRange{}, fmt.Errorf("ParseOpenDateRange: failed to parse start date: %w", err)
+	}
+
+	e, err := parseOpenDateRangeSide(end, unboundedSide)
+	if err != nil {
+		return OpenDateRange{}, fmt.Errorf("ParseOpenDateRange: failed to parse end date: %w", err)
+	}
+
+	r, err := NewOpenDateRange(s, e)
+	if err != nil {
+		return OpenDateRange{}, fmt.Errorf("ParseOpenDateRange: %w", err)
+	}
+
+	return r, nil
+}
+
+// MustParseOpenDateRange parses a string and returns an OpenDateRange instance.
+// It panics if the parsing fails.
+func MustParseOpenDateRange(value string) OpenDateRange {
+	r, err := ParseOpenDateRange(value)
+	if err != nil {
+		panic(err)
+	}
+
+	return r
+}
+
+// parseHalfOpenDateRange parses a string in the format "[2006-01-02,2006-01-02)".
+// The opening bracket may be "(" only when the start is unbounded.
+func parseHalfOpenDateRange(value string) (OpenDateRange, error) {
+	invalid := fmt.Errorf("ParseOpenDateRange: failed to parse %q: %w", value, ErrInvalidOpenDateRange)
+
+	if len(value) < 3 || !strings.HasSuffix(value, ")") {
+		return OpenDateRange{}, invalid
+	}
+
+	start, end, found := strings.Cut(value[1:len(value)-1], ",")
+	if !found || (value[0] == '(' && start != "") {
+		return OpenDateRange{}, invalid
+	}
+
+	s, err := parseOpenDateRangeSide(start, "")
+	if err != nil {
+		return OpenDateRange{}, fmt.Errorf("ParseOpenDateRange: failed to parse start date: %w", err)
+	}
+
+	e, err := parseOpenDateRangeSide(end, "")
+	if err != nil {
+		return OpenDateRange{}, fmt.Errorf("ParseOpenDateRange: failed to parse end date: %w", err)
+	}
+
+	r, err := NewHalfOpenDateRange(s, e)
+	if err != nil {
+		return OpenDateRange{}, fmt.Errorf("ParseOpenDateRange: %w", err)
+	}
+
+	return r, nil
+}
+
+// parseOpenDateRangeSide parses a side of an OpenDateRange, returning null if it equals the unbounded marker.
+func parseOpenDateRangeSide(value, unbounded string) (NullDate, error) {
+	if value == unbounded {
+		return NullDateForNull(), nil
+	}
+
+	d, err := Parse(value)
+	if err != nil {
+		return NullDateForNull(), err
+	}
+
+	return NullDateFromDate(d), nil
+}
+
+// validateOpenDateRange validates the start date and the inclusive last date of an OpenDateRange.
+func validateOpenDateRange(start, last NullDate) error {
+	if start.IsNull() || last.IsNull() {
+		return nil
+	}
+
+	if start.date.Location() != last.date.Location() {
+		return ErrDifferentTimeZone
+	}
+
+	if last.date.Before(start.date) {
+		return ErrEndDateIsBeforeStartDate
+	}
+
+	return nil
+}
+
+// Determination methods
+// --------------------------------------------------
+
+// IsHalfOpen checks if the end date of the OpenDateRange instance is exclusive.
+func (r OpenDateRange) IsHalfOpen() bool {
+	return r.halfOpen
+}
+
+// IsStartUnbounded checks if the OpenDateRange instance extends infinitely into the past.
+func (r OpenDateRange) IsStartUnbounded() bool {
+	return r.start.IsNull()
+}
+
+// IsEndUnbounded checks if the OpenDateRange instance extends infinitely into the future.
+func (r OpenDateRange) IsEndUnbounded() bool {
+	return r.last.IsNull()
+}
+
+// IsBounded checks if both sides of the OpenDateRange instance are bounded.
+func (r OpenDateRange) IsBounded() bool {
+	return r.start.IsNotNull() && r.last.IsNotNull()
+}
+
+// Comparison methods
+// --------------------------------------------------
+
+// Equal checks if the OpenDateRange instance contains the same dates as another OpenDateRange instance,
+// regardless of whether they are half-open.
+func (r OpenDateRange) Equal(target OpenDateRange) bool {
+	return equalOpenDateRangeSides(r.start, target.start) &&
+		equalOpenDateRangeSides(r.last, target.last)
+}
+
+// equalOpenDateRangeSides checks if both sides are unbounded, or bounded on the same date.
+func equalOpenDateRangeSides(a, b NullDate) bool {
+	if a.IsNull() || b.IsNull() {
+		return a.IsNull() == b.IsNull()
+	}
+
+	return a.date.Equal(b.date)
+}
+
+// NotEqual checks if the OpenDateRange instance does not contain the same dates as another OpenDateRange instance.
+func (r OpenDateRange) NotEqual(target OpenDateRange) bool {
+	return !r.Equal(target)
+}
+
+// Contains checks if the OpenDateRange instance contains the specified date.
+func (r OpenDateRange) Contains(date Date) bool {
+	return (r.start.IsNull() || r.start.date.BeforeOrEqual(date)) &&
+		(r.last.IsNull() || r.last.date.AfterOrEqual(date))
+}
+
+// OverlapsWith checks if the OpenDateRange instance overlaps with another OpenDateRange instance.
+func (r OpenDateRange) OverlapsWith(target OpenDateRange) bool {
+	return (r.last.IsNull() || target.start.IsNull() || r.last.date.AfterOrEqual(target.start.date)) &&
+		(target.last.IsNull() || r.start.IsNull() || target.last.date.AfterOrEqual(r.start.date))
+}
+
+// Conversion methods
+// --------------------------------------------------
+
+// Start returns the start date of the OpenDateRange instance, which is null if the start is unbounded.
+func (r OpenDateRange) Start() NullDate {
+	return r.start
+}
+
+// End returns the end date of the OpenDateRange instance, which is null if the end is unbounded.
+// It is exclusive if the OpenDateRange instance is half-open.
+func (r OpenDateRange) End() NullDate {
+	if r.halfOpen {
+		return r.last.Map(Date.AddDay)
+	}
+
+	return r.last
+}
+
+// LastDate returns the last date contained in the OpenDateRange instance, which is null if the end is unbounded.
+func (r OpenDateRange) LastDate() NullDate {
+	return r.last
+}
+
+// GetOverlapping returns the overlapping OpenDateRange between the OpenDateRange instance and another OpenDateRange instance.
+// The result is half-open if the OpenDateRange instance is.
+func (r OpenDateRange) GetOverlapping(target OpenDateRange) (OpenDateRange, error) {
+	if !r.OverlapsWith(target) {
+		return OpenDateRange{}, fmt.Errorf("GetOverlapping: %w", ErrRangesDontOverlap)
+	}
+
+	start := r.start
+	if start.IsNull() || (target.start.IsNotNull() && target.start.date.After(start.date)) {
+		start = target.start
+	}
+
+	last := r.last
+	if last.IsNull() || (target.last.IsNotNull() && target.last.date.Before(last.date)) {
+		last = target.last
+	}
+
+	return OpenDateRange{start: start, last: last, halfOpen: r.halfOpen}, nil
+}
+
+// Closed returns the OpenDateRange instance as a closed range containing the same dates.
+func (r OpenDateRange) Closed() OpenDateRange {
+	r.halfOpen = false
+
+	return r
+}
+
+// HalfOpen returns the OpenDateRange instance as a half-open range containing the same dates.
+func (r OpenDateRange) HalfOpen() OpenDateRange {
+	r.halfOpen = true
+
+	return r
+}
+
+// ToDateRange converts the OpenDateRange instance to a DateRange instance.
+// It returns an error if either side is unbounded.
+func (r OpenDateRange) ToDateRange() (DateRange, error) {
+	if !r.IsBounded() {
+		return ZeroDateRange(), fmt.Errorf("ToDateRange: %w", ErrDateRangeIsUnbounded)
+	}
+
+	return DateRange{r.start.date, r.last.date}, nil
+}
+
+// String returns the string representation of the OpenDateRange instance.
+// Closed ranges are formatted as "start/end" with ".." for an unbounded side, such as "2024-04-01/..",
+// and half-open ranges as "[start,end)" with an empty unbounded side, such as "[2024-04-01,)".
+func (r OpenDateRange) String() string {
+	if r.halfOpen {
+		return "[" + formatOpenDateRangeSide(r.start, "") + "," + formatOpenDateRangeSide(r.End(), "") + ")"
+	}
+
+	return formatOpenDateRangeSide(r.start, unboundedSide) + "/" + formatOpenDateRangeSide(r.last, unboundedSide)
+}
+
+// formatOpenDateRangeSide formats a side of an OpenDateRange, returning the unbounded marker if it is null.
+func formatOpenDateRangeSide(nd NullDate, unbounded string) string {
+	if nd.IsNull() {
+		return unbounded
+	}
+
+	return nd.date.String()
+}
+
+// Conversion methods of DateRange
+// --------------------------------------------------
+
+// ToOpenDateRange converts the DateRange instance to a closed OpenDateRange instance.
+func (r DateRange) ToOpenDateRange() OpenDateRange {
+	return OpenDateRange{start: NullDateFromDate(r.start), last: NullDateFromDate(r.end)}
+}
+
+// Marshalling methods
+// --------------------------------------------------
+
+// Value returns the driver.Value representation of the OpenDateRange instance as a PostgreSQL daterange literal
+// in the canonical half-open form, where an unbounded side is empty, such as "[2024-04-01,)".
+func (r OpenDateRange) Value() (driver.Value, error) {
+	return formatRangeLiteral(r.start, r.last), nil
+}
+
+// Scan scans a PostgreSQL daterange value into the OpenDateRange instance.
+// It accepts any combination of inclusive and exclusive bounds and infinite bounds.
+// The result is half-open if the upper bound is exclusive, as in the canonical form of PostgreSQL.
+// It returns an error for "empty", which OpenDateRange cannot represent.
+func (r *OpenDateRange) Scan(value interface{}) error {
+	rl, ok, err := scanRangeLiteral(value)
+	if err != nil {
+		return fmt.Errorf("OpenDateRange.Scan: %w", err)
+	}
+	if !ok {
+		return nil
+	}
+
+	if rl.empty {
+		return fmt.Errorf("OpenDateRange.Scan: %w", ErrDateRangeIsEmpty)
+	}
+
+	*r = OpenDateRange{start: rl.start, last: rl.last, halfOpen: rl.upperExclusive}
+
+	return nil
+}
+
+// MarshalText marshals the OpenDateRange instance to a text representation.
+func (r *OpenDateRange) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+// UnmarshalText unmarshals a text representation into the OpenDateRange instance.
+func (r *OpenDateRange) UnmarshalText(text []byte) error {
+	openDateRange, err := ParseOpenDateRange(string(text))
+	if err != nil {
+		return fmt.Errorf("OpenDateRange.UnmarshalText: %w", err)
+	}
+
+	*r = openDateRange
+
+	return nil
+}
+
+// MarshalJSON marshals the OpenDateRange instance to a JSON object like DateRange,
+// in which an unbounded side is null and half-open ranges have "halfOpen": true.
+func (r OpenDateRange) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Start    NullDate `json:"start"`
+		End      NullDate `json:"end"`
+		HalfOpen bool     `json:"halfOpen,omitempty"`
+	}{
+		Start:    r.start,
+		End:      r.End(),
+		HalfOpen: r.halfOpen,
+	})
+}
+
+// UnmarshalJSON unmarshals a JSON representation into the OpenDateRange instance.
+// It accepts both the JSON object produced by MarshalJSON and a JSON string in the format of ParseOpenDateRange.
+func (r *OpenDateRange) UnmarshalJSON(data []byte) error {
+	if bytes.HasPrefix(data, []byte(`"`)) {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return fmt.Errorf("OpenDateRange.UnmarshalJSON: %w", err)
+		}
+
+		openDateRange, err := ParseOpenDateRange(s)
+		if err != nil {
+			return fmt.Errorf("OpenDateRange.UnmarshalJSON: %w", err)
+		}
+
+		*r = openDateRange
+
+		return nil
+	}
+
+	var v struct {
+		Start    NullDate `json:"start"`
+		End      NullDate `json:"end"`
+		HalfOpen bool     `json:"halfOpen"`
+	}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return fmt.Errorf("OpenDateRange.UnmarshalJSON: %w", err)
+	}
+
+	newOpenDateRange := NewOpenDateRange
+	if v.HalfOpen {
+		newOpenDateRange = NewHalfOpenDateRange
+	}
+
+	openDateRange, err := newOpenDateRange(v.Start, v.End)
+	if err != nil {
+		return fmt.Errorf("OpenDateRange.UnmarshalJSON: %w", err)
+	}
+
+	*r = openDateRange
+
+	return nil
+}
@@ -0,0 +1,488 @@
+package date
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Factory functions
+// --------------------------------------------------
+
+func TestNewOpenDateRange(t *testing.T) {
+	tests := []struct {
+		start   NullDate
+		end     NullDate
+		want    string
+		wantErr error
+	}{
+		{NullDateFromDate(MustParse("2024-04-01")), NullDateFromDate(MustParse("2024-04-30")), "2024-04-01/2024-04-30", nil},
+		{NullDateFromDate(MustParse("2024-04-01")), NullDateFromDate(MustParse("2024-04-01")), "2024-04-01/2024-04-01", nil},
+		{NullDateFromDate(MustParse("2024-04-01")), NullDateForNull(), "2024-04-01/..", nil},
+		{NullDateForNull(), NullDateFromDate(MustParse("2024-04-30")), "../2024-04-30", nil},
+		{NullDateForNull(), NullDateForNull(), "../..", nil},
+		{NullDateFromDate(MustParse("2024-04-30")), NullDateFromDate(MustParse("2024-04-01")), "", ErrEndDateIsBeforeStartDate},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`NewOpenDateRange(NullDate{"%s"}, NullDate{"%s"})`, tt.start, tt.end)
+
+		t.Run(testcase, func(t *testing.T) {
+			r, err := NewOpenDateRange(tt.start, tt.end)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			assert.Nil(t, err, "Expected no error, got %v", err)
+			assert.Equal(t, tt.want, r.String())
+			assert.False(t, r.IsHalfOpen())
+		})
+	}
+}
+
+func TestNewHalfOpenDateRange(t *testing.T) {
+	tests := []struct {
+		start    NullDate
+		end      NullDate
+		want     string
+		wantLast string
+		wantErr  error
+	}{
+		{NullDateFromDate(MustParse("2024-04-01")), NullDateFromDate(MustParse("2024-05-01")), "[2024-04-01,2024-05-01)", "2024-04-30", nil},
+		{NullDateFromDate(MustParse("2024-04-01")), NullDateFromDate(MustParse("2024-04-02")), "[2024-04-01,2024-04-02)", "2024-04-01", nil},
+		{NullDateFromDate(MustParse("2024-04-01")), NullDateForNull(), "[2024-04-01,)", "null", nil},
+		{NullDateForNull(), NullDateFromDate(MustParse("2024-05-01")), "[,2024-05-01)", "2024-04-30", nil},
+		{NullDateFromDate(MustParse("2024-04-01")), NullDateFromDate(MustParse("2024-04-01")), "", "", ErrEndDateIsBeforeStartDate},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`NewHalfOpenDateRange(NullDate{"%s"}, NullDate{"%s"})`, tt.start, tt.end)
+
+		t.Run(testcase, func(t *testing.T) {
+			r, err := NewHalfOpenDateRange(tt.start, tt.end)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			assert.Nil(t, err, "Expected no error, got %v", err)
+			assert.Equal(t, tt.want, r.String())
+			assert.Equal(t, tt.wantLast, r.LastDate().String())
+			assert.True(t, r.End().Equal(tt.end))
+			assert.True(t, r.IsHalfOpen())
+		})
+	}
+}
+
+func TestOpenDateRangeShorthands(t *testing.T) {
+	tests := []struct {
+		r    OpenDateRange
+		want string
+	}{
+		{DateRangeFrom(MustParse("2024-04-01")), "2024-04-01/.."},
+		{DateRangeUntil(MustParse("2024-04-30")), "../2024-04-30"},
+		{UnboundedDateRange(), "../.."},
+		{OpenDateRange{}, "../.."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.r.String())
+		})
+	}
+}
+
+func TestParseOpenDateRange(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    string
+		wantErr error
+	}{
+		{"2024-04-01/2024-04-30", "2024-04-01/2024-04-30", nil},
+		{"2024-04-01/..", "2024-04-01/..", nil},
+		{"../2024-04-30", "../2024-04-30", nil},
+		{"../..", "../..", nil},
+		{"[2024-04-01,2024-05-01)", "[2024-04-01,2024-05-01)", nil},
+		{"[2024-04-01,)", "[2024-04-01,)", nil},
+		{"[,2024-05-01)", "[,2024-05-01)", nil},
+		{"(,2024-05-01)", "[,2024-05-01)", nil},
+		{"2024-04-01", "", ErrInvalidOpenDateRange},
+		{"[2024-04-01,2024-05-01]", "", ErrInvalidOpenDateRange},
+		{"(2024-04-01,2024-05-01)", "", ErrInvalidOpenDateRange},
+		{"[2024-04-01)", "", ErrInvalidOpenDateRange},
+		{"2024-04-30/2024-04-01", "", ErrEndDateIsBeforeStartDate},
+		{"[2024-04-01,2024-04-01)", "", ErrEndDateIsBeforeStartDate},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`ParseOpenDateRange("%s")`, tt.value)
+
+		t.Run(testcase, func(t *testing.T) {
+			r, err := ParseOpenDateRange(tt.value)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			assert.Nil(t, err, "Expected no error, got %v", err)
+			assert.Equal(t, tt.want, r.String())
+		})
+	}
+
+	for _, value := range []string{"2024-04-01/", "2024-04-01/...", "[2024-04-01,2024-13-01)"} {
+		t.Run(fmt.Sprintf(`ParseOpenDateRange("%s")`, value), func(t *testing.T) {
+			_, err := ParseOpenDateRange(value)
+			assert.Error(t, err)
+		})
+	}
+}
+
+// Determination methods
+// --------------------------------------------------
+
+func TestOpenDateRangeBounds(t *testing.T) {
+	tests := []struct {
+		r                  OpenDateRange
+		wantStartUnbounded bool
+		wantEndUnbounded   bool
+		wantBounded        bool
+		wantStart, wantEnd string
+		wantLast           string
+	}{
+		{MustParseOpenDateRange("2024-04-01/2024-04-30"), false, false, true, "2024-04-01", "2024-04-30", "2024-04-30"},
+		{MustParseOpenDateRange("2024-04-01/.."), false, true, false, "2024-04-01", "null", "null"},
+		{MustParseOpenDateRange("../2024-04-30"), true, false, false, "null", "2024-04-30", "2024-04-30"},
+		{MustParseOpenDateRange("[2024-04-01,2024-05-01)"), false, false, true, "2024-04-01", "2024-05-01", "2024-04-30"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`OpenDateRange{"%s"}`, tt.r)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.wantStartUnbounded, tt.r.IsStartUnbounded())
+			assert.Equal(t, tt.wantEndUnbounded, tt.r.IsEndUnbounded())
+			assert.Equal(t, tt.wantBounded, tt.r.IsBounded())
+			assert.Equal(t, tt.wantStart, tt.r.Start().String())
+			assert.Equal(t, tt.wantEnd, tt.r.End().String())
+			assert.Equal(t, tt.wantLast, tt.r.LastDate().String())
+		})
+	}
+}
+
+// Comparison methods
+// --------------------------------------------------
+
+func TestOpenDateRangeEqual(t *testing.T) {
+	tests := []struct {
+		r, target OpenDateRange
+		want      bool
+	}{
+		{MustParseOpenDateRange("2024-04-01/2024-04-30"), MustParseOpenDateRange("[2024-04-01,2024-05-01)"), true},
+		{MustParseOpenDateRange("2024-04-01/.."), MustParseOpenDateRange("[2024-04-01,)"), true},
+		{MustParseOpenDateRange("2024-04-01/.."), MustParseOpenDateRange("2024-04-01/2024-04-30"), false},
+		{MustParseOpenDateRange("../.."), UnboundedDateRange(), true},
+		{MustParseOpenDateRange("../2024-04-30"), MustParseOpenDateRange("0001-01-01/2024-04-30"), false},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`OpenDateRange{"%s"}.Equal(OpenDateRange{"%s"})`, tt.r, tt.target)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.r.Equal(tt.target))
+			assert.Equal(t, tt.want, tt.target.Equal(tt.r))
+			assert.Equal(t, !tt.want, tt.r.NotEqual(tt.target))
+		})
+	}
+}
+
+func TestOpenDateRangeContains(t *testing.T) {
+	tests := []struct {
+		r    OpenDateRange
+		date Date
+		want bool
+	}{
+		{MustParseOpenDateRange("2024-04-01/.."), MustParse("2024-03-31"), false},
+		{MustParseOpenDateRange("2024-04-01/.."), MustParse("2024-04-01"), true},
+		{MustParseOpenDateRange("2024-04-01/.."), MustParse("9999-12-31"), true},
+		{MustParseOpenDateRange("../2024-04-30"), MustParse("0001-01-01"), true},
+		{MustParseOpenDateRange("../2024-04-30"), MustParse("2024-05-01"), false},
+		{MustParseOpenDateRange("[2024-04-01,2024-05-01)"), MustParse("2024-04-30"), true},
+		{MustParseOpenDateRange("[2024-04-01,2024-05-01)"), MustParse("2024-05-01"), false},
+		{UnboundedDateRange(), MustParse("2024-04-01"), true},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`OpenDateRange{"%s"}.Contains(Date{"%s"})`, tt.r, tt.date)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.r.Contains(tt.date))
+		})
+	}
+}
+
+func TestOpenDateRangeOverlapsWith(t *testing.T) {
+	tests := []struct {
+		r, target OpenDateRange
+		want      bool
+	}{
+		{MustParseOpenDateRange("2024-04-01/.."), MustParseOpenDateRange("../2024-04-01"), true},
+		{MustParseOpenDateRange("2024-04-01/.."), MustParseOpenDateRange("../2024-03-31"), false},
+		{MustParseOpenDateRange("2024-04-01/.."), MustParseOpenDateRange("2030-01-01/.."), true},
+		{MustParseOpenDateRange("[2024-04-01,2024-05-01)"), MustParseOpenDateRange("2024-05-01/.."), false},
+		{MustParseOpenDateRange("[2024-04-01,2024-05-01)"), MustParseOpenDateRange("2024-04-30/.."), true},
+		{UnboundedDateRange(), MustParseOpenDateRange("2024-04-01/2024-04-01"), true},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`OpenDateRange{"%s"}.OverlapsWith(OpenDateRange{"%s"})`, tt.r, tt.target)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.r.OverlapsWith(tt.target))
+			assert.Equal(t, tt.want, tt.target.OverlapsWith(tt.r))
+		})
+	}
+}
+
+// Conversion methods
+// --------------------------------------------------
+
+func TestOpenDateRangeGetOverlapping(t *testing.T) {
+	tests := []struct {
+		r, target OpenDateRange
+		want      string
+		wantErr   error
+	}{
+		{MustParseOpenDateRange("2024-04-01/.."), MustParseOpenDateRange("../2024-06-30"), "2024-04-01/2024-06-30", nil},
+		{MustParseOpenDateRange("2024-04-01/.."), MustParseOpenDateRange("2024-05-01/.."), "2024-05-01/..", nil},
+		{MustParseOpenDateRange("../.."), MustParseOpenDateRange("../2024-06-30"), "../2024-06-30", nil},
+		{MustParseOpenDateRange("[2024-04-01,)"), MustParseOpenDateRange("../2024-06-30"), "[2024-04-01,2024-07-01)", nil},
+		{MustParseOpenDateRange("2024-04-01/.."), MustParseOpenDateRange("[,2024-07-01)"), "2024-04-01/2024-06-30", nil},
+		{MustParseOpenDateRange("2024-04-01/.."), MustParseOpenDateRange("../2024-03-31"), "", ErrRangesDontOverlap},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`OpenDateRange{"%s"}.GetOverlapping(OpenDateRange{"%s"})`, tt.r, tt.target)
+
+		t.Run(testcase, func(t *testing.T) {
+			r, err := tt.r.GetOverlapping(tt.target)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			assert.Nil(t, err, "Expected no error, got %v", err)
+			assert.Equal(t, tt.want, r.String())
+		})
+	}
+}
+
+func TestOpenDateRangeClosedAndHalfOpen(t *testing.T) {
+	t.Run(`OpenDateRange{"2024-04-01/2024-04-30"}.HalfOpen().Closed()`, func(t *testing.T) {
+		r := MustParseOpenDateRange("2024-04-01/2024-04-30")
+
+		assert.Equal(t, "[2024-04-01,2024-05-01)", r.HalfOpen().String())
+		assert.Equal(t, "2024-04-01/2024-04-30", r.HalfOpen().Closed().String())
+	})
+}
+
+func TestOpenDateRangeToDateRange(t *testing.T) {
+	tests := []struct {
+		r       OpenDateRange
+		want    DateRange
+		wantErr error
+	}{
+		{MustParseOpenDateRange("2024-04-01/2024-04-30"), MustParseDateRange("2024-04-01", "2024-04-30"), nil},
+		{MustParseOpenDateRange("[2024-04-01,2024-05-01)"), MustParseDateRange("2024-04-01", "2024-04-30"), nil},
+		{MustParseOpenDateRange("2024-04-01/.."), ZeroDateRange(), ErrDateRangeIsUnbounded},
+		{MustParseOpenDateRange("../2024-04-30"), ZeroDateRange(), ErrDateRangeIsUnbounded},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`OpenDateRange{"%s"}.ToDateRange()`, tt.r)
+
+		t.Run(testcase, func(t *testing.T) {
+			r, err := tt.r.ToDateRange()
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.Nil(t, err, "Expected no error, got %v", err)
+			}
+
+			assert.Equal(t, tt.want, r)
+		})
+	}
+}
+
+func TestDateRangeToOpenDateRange(t *testing.T) {
+	t.Run(`DateRange{"2024-04-01/2024-04-30"}.ToOpenDateRange()`, func(t *testing.T) {
+		r := MustParseDateRange("2024-04-01", "2024-04-30").ToOpenDateRange()
+
+		assert.Equal(t, "2024-04-01/2024-04-30", r.String())
+		assert.True(t, r.IsBounded())
+	})
+}
+
+// Marshalling methods
+// --------------------------------------------------
+
+func TestOpenDateRangeValue(t *testing.T) {
+	tests := []struct {
+		r         OpenDateRange
+		wantValue driver.Value
+	}{
+		{MustParseOpenDateRange("2024-03-01/2024-03-31"), "[2024-03-01,2024-04-01)"},
+		{MustParseOpenDateRange("[2024-03-01,2024-04-01)"), "[2024-03-01,2024-04-01)"},
+		{MustParseOpenDateRange("2024-03-01/.."), "[2024-03-01,)"},
+		{MustParseOpenDateRange("../2024-03-31"), "[,2024-04-01)"},
+		{UnboundedDateRange(), "[,)"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`OpenDateRange{"%s"}.Value()`, tt.r)
+
+		t.Run(testcase, func(t *testing.T) {
+			value, err := tt.r.Value()
+			assert.Nil(t, err, "Expected no error, got %v", err)
+			assert.Equal(t, tt.wantValue, value)
+		})
+	}
+}
+
+func TestOpenDateRangeScan(t *testing.T) {
+	tests := []struct {
+		value   interface{}
+		want    string
+		wantErr error
+	}{
+		{"[2024-03-01,2024-04-01)", "[2024-03-01,2024-04-01)", nil},
+		{"[2024-03-01,2024-03-31]", "2024-03-01/2024-03-31", nil},
+		{"[2024-03-01,)", "[2024-03-01,)", nil},
+		{"[2024-03-01,infinity)", "[2024-03-01,)", nil},
+		{"(,2024-04-01)", "[,2024-04-01)", nil},
+		{"[-infinity,2024-03-31]", "../2024-03-31", nil},
+		{"(,)", "[,)", nil},
+		{nil, "../..", nil},
+		{"empty", "", ErrDateRangeIsEmpty},
+		{"[2024-03-01,2024-03-01)", "", ErrDateRangeIsEmpty},
+		{"[2024-03-01;2024-04-01)", "", ErrInvalidRangeLiteral},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`OpenDateRange{}.Scan(%#v)`, tt.value)
+
+		t.Run(testcase, func(t *testing.T) {
+			var r OpenDateRange
+			err := r.Scan(tt.value)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+
+			assert.Nil(t, err, "Expected no error, got %v", err)
+			assert.Equal(t, tt.want, r.String())
+		})
+	}
+}
+
+func TestOpenDateRangeMarshalJSON(t *testing.T) {
+	tests := []struct {
+		r    OpenDateRange
+		want string
+	}{
+		{MustParseOpenDateRange("2024-04-01/2024-04-30"), `{"start":"2024-04-01","end":"2024-04-30"}`},
+		{MustParseOpenDateRange("2024-04-01/.."), `{"start":"2024-04-01","end":null}`},
+		{MustParseOpenDateRange("../.."), `{"start":null,"end":null}`},
+		{MustParseOpenDateRange("[2024-04-01,2024-05-01)"), `{"start":"2024-04-01","end":"2024-05-01","halfOpen":true}`},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`OpenDateRange{"%s"}.MarshalJSON()`, tt.r)
+
+		t.Run(testcase, func(t *testing.T) {
+			data, err := json.Marshal(tt.r)
+			assert.Nil(t, err, "Expected no error, got %v", err)
+			assert.Equal(t, tt.want, string(data))
+		})
+	}
+}
+
+func TestOpenDateRangeUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		json    string
+		want    string
+		wantErr bool
+	}{
+		{`{"start":"2024-04-01","end":"2024-04-30"}`, "2024-04-01/2024-04-30", false},
+		{`{"start":"2024-04-01","end":null}`, "2024-04-01/..", false},
+		{`{"start":"2024-04-01"}`, "2024-04-01/..", false},
+		{`{"start":"2024-04-01","end":"2024-05-01","halfOpen":true}`, "[2024-04-01,2024-05-01)", false},
+		{`"2024-04-01/.."`, "2024-04-01/..", false},
+		{`"[,2024-05-01)"`, "[,2024-05-01)", false},
+		{`{"start":"2024-04-30","end":"2024-04-01"}`, "", true},
+		{`"2024-04-01"`, "", true},
+		{`[]`, "", true},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`OpenDateRange.UnmarshalJSON(%s)`, tt.json)
+
+		t.Run(testcase, func(t *testing.T) {
+			var r OpenDateRange
+			err := json.Unmarshal([]byte(tt.json), &r)
+
+			if tt.wantErr {
+				assert.NotNil(t, err)
+
+				return
+			}
+
+			assert.Nil(t, err, "Expected no error, got %v", err)
+			assert.Equal(t, tt.want, r.String())
+		})
+	}
+}
+
+func TestOpenDateRangeTextRoundTrip(t *testing.T) {
+	for _, value := range []string{"2024-04-01/2024-04-30", "2024-04-01/..", "../2024-04-30", "../..", "[2024-04-01,)"} {
+		t.Run(value, func(t *testing.T) {
+			r := MustParseOpenDateRange(value)
+
+			text, err := r.MarshalText()
+			assert.Nil(t, err, "Expected no error, got %v", err)
+
+			var got OpenDateRange
+			assert.Nil(t, got.UnmarshalText(text))
+			assert.True(t, r.Equal(got))
+			assert.Equal(t, r.IsHalfOpen(), got.IsHalfOpen())
+		})
+	}
+}
+
+func TestOpenDateRangeUnmarshalTextError(t *testing.T) {
+	var r OpenDateRange
+	err := r.UnmarshalText([]byte("invalid"))
+
+	assert.ErrorIs(t, err, ErrInvalidOpenDateRange)
+	assert.True(t, strings.HasPrefix(err.Error(), "OpenDateRange.UnmarshalText: "), err.Error())
+
+	err = r.UnmarshalJSON([]byte(`"invalid"`))
+
+	assert.ErrorIs(t, err, ErrInvalidOpenDateRange)
+	assert.True(t, strings.HasPrefix(err.Error(), "OpenDateRange.UnmarshalJSON: "), err.Error())
+}
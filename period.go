@@ -0,0 +1,278 @@
+package date
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidPeriod = fmt.Errorf("invalid ISO 8601 period")
+)
+
+// periodUnits is the order of the units in an ISO 8601 period.
+const periodUnits = "YMWD"
+
+// Period is an immutable struct for a calendar-based amount of time in years, months and days,
+// such as "2 years and 3 months".
+// Unlike a number of days, the length of a Period depends on the date it is added to.
+type Period struct {
+	years  int
+	months int
+	days   int
+}
+
+// Factory functions
+// --------------------------------------------------
+
+// NewPeriod creates a new Period instance with the specified years, months and days.
+// The components are kept as they are, so NewPeriod(0, 14, 0) is not equal to NewPeriod(1, 2, 0) until normalized.
+func NewPeriod(years, months, days int) Period {
+	return Period{
+		years:  years,
+		months: months,
+		days:   days,
+	}
+}
+
+// ZeroPeriod returns a zero value Period instance.
+func ZeroPeriod() Period {
+	return Period{}
+}
+
+// ParsePeriod parses an ISO 8601 period string such as "P1Y2M10D" and returns a Period instance.
+// Weeks are converted to days, and a leading minus sign negates all components, as in "-P1M".
+// Time components such as "PT1H" are not supported.
+func ParsePeriod(value string) (Period, error) {
+	s, negative := strings.CutPrefix(value, "-")
+	if !negative {
+		s = strings.TrimPrefix(s, "+")
+	}
+
+	s, ok := strings.CutPrefix(s, "P")
+	if !ok || s == "" {
+		return ZeroPeriod(), fmt.Errorf("ParsePeriod: failed to parse period %q: %w", value, ErrInvalidPeriod)
+	}
+
+	p := ZeroPeriod()
+	last := -1
+
+	for s != "" {
+		i := strings.IndexAny(s, periodUnits)
+		if i <= 0 {
+			return ZeroPeriod(), fmt.Errorf("ParsePeriod: failed to parse period %q: %w", value, ErrInvalidPeriod)
+		}
+
+		n, err := strconv.Atoi(s[:i])
+		if err != nil {
+			return ZeroPeriod(), fmt.Errorf("ParsePeriod: failed to parse number of period %q: %w", value, ErrInvalidPeriod)
+		}
+
+		unit := strings.IndexByte(periodUnits, s[i])
+		if unit <= last {
+			return ZeroPeriod(), fmt.Errorf("ParsePeriod: units of period %q are out of order: %w", value, ErrInvalidPeriod)
+		}
+		last = unit
+
+		switch s[i] {
+		case 'Y':
+			p.years = n
+		case 'M':
+			p.months = n
+		case 'W':
+			p.days += n * 7
+		case 'D':
+			p.days += n
+		}
+
+		s = s[i+1:]
+	}
+
+	if negative {
+		return p.Negated(), nil
+	}
+
+	return p, nil
+}
+
+// MustParsePeriod parses an ISO 8601 period string such as "P1Y2M10D" and returns a Period instance.
+// It panics if the parsing fails.
+func MustParsePeriod(value string) Period {
+	p, err := ParsePeriod(value)
+	if err != nil {
+		panic(err)
+	}
+
+	return p
+}
+
+// Determination methods
+// --------------------------------------------------
+
+// IsZero checks if all components of the Period instance are zero.
+func (p Period) IsZero() bool {
+	zp := ZeroPeriod()
+
+	return p.Equal(zp)
+}
+
+// IsNegative checks if any component of the Period instance is negative.
+func (p Period) IsNegative() bool {
+	return p.years < 0 || p.months < 0 || p.days < 0
+}
+
+// Comparison methods
+// --------------------------------------------------
+
+// Equal checks if each component of the Period instance is equal to that of another Period instance.
+// Periods are not comparable by length, so P1M and P30D are not equal.
+func (p Period) Equal(period Period) bool {
+	return p.years == period.years && p.months == period.months && p.days == period.days
+}
+
+// NotEqual checks if the Period instance is not equal to another Period instance.
+func (p Period) NotEqual(period Period) bool {
+	return !p.Equal(period)
+}
+
+// Addition and Subtraction methods
+// --------------------------------------------------
+
+// Add adds each component of another Period instance to the Period instance.
+func (p Period) Add(period Period) Period {
+	return Period{
+		years:  p.years + period.years,
+		months: p.months + period.months,
+		days:   p.days + period.days,
+	}
+}
+
+// Sub subtracts each component of another Period instance from the Period instance.
+func (p Period) Sub(period Period) Period {
+	return p.Add(period.Negated())
+}
+
+// Negated returns the Period instance with all components negated.
+func (p Period) Negated() Period {
+	return Period{
+		years:  p.years * -1,
+		months: p.months * -1,
+		days:   p.days * -1,
+	}
+}
+
+// Multiplied returns the Period instance with each component multiplied by the specified scalar.
+func (p Period) Multiplied(scalar int) Period {
+	return Period{
+		years:  p.years * scalar,
+		months: p.months * scalar,
+		days:   p.days * scalar,
+	}
+}
+
+// Normalized returns the Period instance with months of 12 or more carried into years.
+// Days are left as they are because the number of days in a month varies.
+func (p Period) Normalized() Period {
+	months := p.TotalMonths()
+
+	return Period{
+		years:  months / 12,
+		months: months % 12,
+		days:   p.days,
+	}
+}
+
+// Conversion methods
+// --------------------------------------------------
+
+// Years returns the years component of the Period instance.
+func (p Period) Years() int {
+	return p.years
+}
+
+// Months returns the months component of the Period instance.
+func (p Period) Months() int {
+	return p.months
+}
+
+// Days returns the days component of the Period instance.
+func (p Period) Days() int {
+	return p.days
+}
+
+// TotalMonths returns the years and months components of the Period instance in months.
+func (p Period) TotalMonths() int {
+	return p.years*12 + p.months
+}
+
+// String returns the ISO 8601 representation of the Period instance, such as "P1Y2M10D".
+// A zero Period is formatted as "P0D".
+func (p Period) String() string {
+	if p.IsZero() {
+		return "P0D"
+	}
+
+	var b strings.Builder
+	b.WriteString("P")
+
+	if p.years != 0 {
+		b.WriteString(strconv.Itoa(p.years) + "Y")
+	}
+	if p.months != 0 {
+		b.WriteString(strconv.Itoa(p.months) + "M")
+	}
+	if p.days != 0 {
+		b.WriteString(strconv.Itoa(p.days) + "D")
+	}
+
+	return b.String()
+}
+
+// Marshalling methods
+// --------------------------------------------------
+
+// MarshalText marshals the Period instance to a text representation.
+func (p *Period) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+// UnmarshalText unmarshals a text representation into the Period instance.
+func (p *Period) UnmarshalText(text []byte) error {
+	period, err := ParsePeriod(string(text))
+	if err != nil {
+		return fmt.Errorf("Period.UnmarshalText: %w", err)
+	}
+
+	*p = period
+
+	return nil
+}
+
+// MarshalJSON marshals the Period instance to a JSON representation.
+func (p Period) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + p.String() + `"`), nil
+}
+
+// UnmarshalJSON unmarshals a JSON representation into the Period instance.
+func (p *Period) UnmarshalJSON(json []byte) error {
+	value := strings.Trim(string(json), `"`)
+
+	period, err := ParsePeriod(value)
+	if err != nil {
+		return fmt.Errorf("Period.UnmarshalJSON: %w", err)
+	}
+
+	*p = period
+
+	return nil
+}
+
+// daysBetween returns the number of days from start to end, regardless of the time zone offsets of the dates.
+// It counts Unix days instead of subtracting times, because time.Duration saturates at about 292 years.
+func daysBetween(start, end Date) int {
+	s := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+	e := time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, time.UTC)
+
+	return int((e.Unix() - s.Unix()) / 86400)
+}
@@ -0,0 +1,364 @@
+package date
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Factory functions
+// --------------------------------------------------
+
+func TestNewPeriod(t *testing.T) {
+	tests := []struct {
+		years, months, days int
+		want                string
+	}{
+		{1, 2, 10, "P1Y2M10D"},
+		{0, 14, 0, "P14M"},
+		{0, 0, 0, "P0D"},
+		{-1, 0, -3, "P-1Y-3D"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf("NewPeriod(%d, %d, %d)", tt.years, tt.months, tt.days)
+
+		t.Run(testcase, func(t *testing.T) {
+			p := NewPeriod(tt.years, tt.months, tt.days)
+			assert.Equal(t, tt.want, p.String())
+			assert.Equal(t, tt.years, p.Years(), "Years")
+			assert.Equal(t, tt.months, p.Months(), "Months")
+			assert.Equal(t, tt.days, p.Days(), "Days")
+		})
+	}
+}
+
+func TestZeroPeriod(t *testing.T) {
+	t.Run("ZeroPeriod()", func(t *testing.T) {
+		period := ZeroPeriod()
+		assert.True(t, period.IsZero(), "Period created was not zero value")
+		assert.Equal(t, "P0D", period.String())
+	})
+}
+
+func TestParsePeriod(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    Period
+		wantErr error
+	}{
+		{"P1Y2M10D", NewPeriod(1, 2, 10), nil},
+		{"P3M", NewPeriod(0, 3, 0), nil},
+		{"P2W", NewPeriod(0, 0, 14), nil},
+		{"P1W3D", NewPeriod(0, 0, 10), nil},
+		{"P0D", ZeroPeriod(), nil},
+		{"-P1Y2M", NewPeriod(-1, -2, 0), nil},
+		{"+P1D", NewPeriod(0, 0, 1), nil},
+		{"P-1M5D", NewPeriod(0, -1, 5), nil},
+		{"P", ZeroPeriod(), ErrInvalidPeriod},
+		{"1Y", ZeroPeriod(), ErrInvalidPeriod},
+		{"P1D2M", ZeroPeriod(), ErrInvalidPeriod},
+		{"P1Y1Y", ZeroPeriod(), ErrInvalidPeriod},
+		{"PT1H", ZeroPeriod(), ErrInvalidPeriod},
+		{"P1DT1H", ZeroPeriod(), ErrInvalidPeriod},
+		{"PxD", ZeroPeriod(), ErrInvalidPeriod},
+		{"PY", ZeroPeriod(), ErrInvalidPeriod},
+		{"", ZeroPeriod(), ErrInvalidPeriod},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`ParsePeriod("%s")`, tt.value)
+
+		t.Run(testcase, func(t *testing.T) {
+			p, err := ParsePeriod(tt.value)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.Nil(t, err, "Expected no error, got %v", err)
+			}
+			assert.Equal(t, tt.want, p)
+		})
+	}
+}
+
+func TestMustParsePeriod(t *testing.T) {
+	tests := []struct {
+		value     string
+		wantPanic bool
+	}{
+		{"P1Y2M10D", false},
+		{"invalid", true},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`MustParsePeriod("%s")`, tt.value)
+
+		t.Run(testcase, func(t *testing.T) {
+			if tt.wantPanic {
+				assert.Panics(t, func() { MustParsePeriod(tt.value) })
+			} else {
+				assert.Equal(t, tt.value, MustParsePeriod(tt.value).String())
+			}
+		})
+	}
+}
+
+// Determination methods
+// --------------------------------------------------
+
+func TestPeriodIsZeroAndIsNegative(t *testing.T) {
+	tests := []struct {
+		period   Period
+		zero     bool
+		negative bool
+	}{
+		{ZeroPeriod(), true, false},
+		{NewPeriod(0, 0, 1), false, false},
+		{NewPeriod(1, -1, 0), false, true},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Period{"%s"}.IsZero() and IsNegative()`, tt.period)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.zero, tt.period.IsZero(), "IsZero")
+			assert.Equal(t, tt.negative, tt.period.IsNegative(), "IsNegative")
+		})
+	}
+}
+
+// Comparison methods
+// --------------------------------------------------
+
+func TestPeriodEqual(t *testing.T) {
+	tests := []struct {
+		period, target Period
+		want           bool
+	}{
+		{NewPeriod(1, 2, 3), NewPeriod(1, 2, 3), true},
+		{NewPeriod(1, 2, 0), NewPeriod(0, 14, 0), false},
+		{NewPeriod(0, 1, 0), NewPeriod(0, 0, 30), false},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Period{"%s"}.Equal(Period{"%s"})`, tt.period, tt.target)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.period.Equal(tt.target), "Equal")
+			assert.Equal(t, !tt.want, tt.period.NotEqual(tt.target), "NotEqual")
+		})
+	}
+}
+
+// Addition and Subtraction methods
+// --------------------------------------------------
+
+func TestPeriodAddAndSub(t *testing.T) {
+	p := NewPeriod(1, 2, 3)
+	q := NewPeriod(0, 11, 30)
+
+	t.Run(`Period{"P1Y2M3D"}.Add(Period{"P11M30D"})`, func(t *testing.T) {
+		assert.Equal(t, NewPeriod(1, 13, 33), p.Add(q))
+	})
+	t.Run(`Period{"P1Y2M3D"}.Sub(Period{"P11M30D"})`, func(t *testing.T) {
+		assert.Equal(t, NewPeriod(1, -9, -27), p.Sub(q))
+	})
+	t.Run(`Period{"P1Y2M3D"}.Negated()`, func(t *testing.T) {
+		assert.Equal(t, NewPeriod(-1, -2, -3), p.Negated())
+	})
+}
+
+func TestPeriodMultiplied(t *testing.T) {
+	tests := []struct {
+		period Period
+		scalar int
+		want   Period
+	}{
+		{NewPeriod(1, 2, 3), 3, NewPeriod(3, 6, 9)},
+		{NewPeriod(0, 3, 0), -2, NewPeriod(0, -6, 0)},
+		{NewPeriod(1, 2, 3), 0, ZeroPeriod()},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Period{"%s"}.Multiplied(%d)`, tt.period, tt.scalar)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.period.Multiplied(tt.scalar))
+		})
+	}
+}
+
+func TestPeriodNormalized(t *testing.T) {
+	tests := []struct {
+		period Period
+		want   Period
+	}{
+		{NewPeriod(0, 14, 40), NewPeriod(1, 2, 40)},
+		{NewPeriod(1, -3, 0), NewPeriod(0, 9, 0)},
+		{NewPeriod(-1, -14, 0), NewPeriod(-2, -2, 0)},
+		{NewPeriod(0, -3, 0), NewPeriod(0, -3, 0)},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Period{"%s"}.Normalized()`, tt.period)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.period.Normalized())
+			assert.Equal(t, tt.period.TotalMonths(), tt.want.TotalMonths(), "TotalMonths")
+		})
+	}
+}
+
+// Date methods
+// --------------------------------------------------
+
+func TestDateAddPeriod(t *testing.T) {
+	tests := []struct {
+		date   Date
+		period Period
+		want   string
+	}{
+		{MustParse("2024-01-15"), NewPeriod(1, 2, 10), "2025-03-25"},
+		{MustParse("2024-01-31"), NewPeriod(0, 1, 0), "2024-02-29"},
+		{MustParse("2024-01-31"), NewPeriod(0, 1, 1), "2024-03-01"},
+		{MustParse("2024-02-29"), NewPeriod(1, 0, 0), "2025-02-28"},
+		{MustParse("2024-03-31"), NewPeriod(0, -1, 0), "2024-02-29"},
+		{MustParse("2024-01-15"), ZeroPeriod(), "2024-01-15"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Date{"%s"}.AddPeriod(Period{"%s"})`, tt.date, tt.period)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.date.AddPeriod(tt.period).String(), "AddPeriod")
+			assert.Equal(t, tt.want, tt.date.SubPeriod(tt.period.Negated()).String(), "SubPeriod")
+		})
+	}
+}
+
+func TestDateDiff(t *testing.T) {
+	tests := []struct {
+		date, target Date
+		want         string
+	}{
+		{MustParse("2024-01-15"), MustParse("2024-01-15"), "P0D"},
+		{MustParse("2024-01-15"), MustParse("2026-04-15"), "P2Y3M"},
+		{MustParse("2024-01-15"), MustParse("2025-03-25"), "P1Y2M10D"},
+		{MustParse("2024-01-31"), MustParse("2024-02-29"), "P1M"},
+		{MustParse("2024-01-31"), MustParse("2024-03-01"), "P1M1D"},
+		{MustParse("2024-01-20"), MustParse("2024-02-10"), "P21D"},
+		{MustParse("2024-02-29"), MustParse("2025-02-28"), "P1Y"},
+		{MustParse("2024-03-10"), MustParse("2024-01-20"), "P-1M-21D"},
+		{MustParse("2024-03-31"), MustParse("2024-02-15"), "P-1M-14D"},
+		{MustParse("2025-03-25"), MustParse("2024-01-15"), "P-1Y-2M-10D"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Date{"%s"}.Diff(Date{"%s"})`, tt.date, tt.target)
+
+		t.Run(testcase, func(t *testing.T) {
+			p := tt.date.Diff(tt.target)
+			assert.Equal(t, tt.want, p.String())
+			assert.Equal(t, tt.target, tt.date.AddPeriod(p), "AddPeriod(Diff)")
+		})
+	}
+}
+
+// Marshalling methods
+// --------------------------------------------------
+
+func TestPeriodMarshalText(t *testing.T) {
+	tests := []struct {
+		period Period
+		want   string
+	}{
+		{NewPeriod(1, 2, 10), "P1Y2M10D"},
+		{ZeroPeriod(), "P0D"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Period{"%s"}.MarshalText()`, tt.period)
+
+		t.Run(testcase, func(t *testing.T) {
+			text, err := tt.period.MarshalText()
+			assert.Nil(t, err, "Excepted no error, got %v", err)
+			assert.Equal(t, tt.want, string(text))
+		})
+	}
+}
+
+func TestPeriodUnmarshalText(t *testing.T) {
+	tests := []struct {
+		text []byte
+		want string
+	}{
+		{[]byte("P1Y2M10D"), "P1Y2M10D"},
+		{[]byte{}, "error"},
+		{[]byte("invalid"), "error"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Period{}.UnmarshalText("%s")`, tt.text)
+
+		t.Run(testcase, func(t *testing.T) {
+			p := ZeroPeriod()
+			err := p.UnmarshalText(tt.text)
+			if tt.want == "error" {
+				assert.Error(t, err, "Unable to parse")
+				assert.True(t, p.IsZero(), "period is not zero")
+			} else {
+				assert.Nil(t, err, "Expected no error, got %v", err)
+				assert.Equal(t, tt.want, p.String())
+			}
+		})
+	}
+}
+
+func TestPeriodMarshalJSON(t *testing.T) {
+	tests := []struct {
+		period Period
+		want   string
+	}{
+		{NewPeriod(1, 2, 10), `"P1Y2M10D"`},
+		{ZeroPeriod(), `"P0D"`},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Period{"%s"}.MarshalJSON()`, tt.period)
+
+		t.Run(testcase, func(t *testing.T) {
+			json, err := tt.period.MarshalJSON()
+			assert.Nil(t, err, "Excepted no error, got %v", err)
+			assert.Equal(t, tt.want, string(json))
+		})
+	}
+}
+
+func TestPeriodUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		json []byte
+		want string
+	}{
+		{[]byte(`"P1Y2M10D"`), "P1Y2M10D"},
+		{[]byte(`"-P3W"`), "P-21D"},
+		{[]byte{}, "error"},
+		{[]byte("invalid"), "error"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf("Period{}.UnmarshalJSON(`%s`)", tt.json)
+
+		t.Run(testcase, func(t *testing.T) {
+			p := ZeroPeriod()
+			err := p.UnmarshalJSON(tt.json)
+			if tt.want == "error" {
+				assert.Error(t, err, "Unable to parse")
+				assert.True(t, p.IsZero(), "period is not zero")
+			} else {
+				assert.Nil(t, err, "Expected no error, got %v", err)
+				assert.Equal(t, tt.want, p.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package date
+
+import (
+	"fmt"
+	"strings"
+)
+
+var (
+	ErrInvalidRangeLiteral = fmt.Errorf("invalid PostgreSQL range literal")
+	ErrDateRangeIsEmpty    = fmt.Errorf("date range is empty")
+)
+
+// rangeLiteral is a PostgreSQL daterange literal converted to inclusive bounds.
+// A null bound is unbounded.
+type rangeLiteral struct {
+	empty          bool
+	start          NullDate
+	last           NullDate
+	upperExclusive bool
+}
+
+// scanRangeLiteral converts a value scanned from a daterange column into a rangeLiteral.
+// It reports false if the value is nil.
+func scanRangeLiteral(value interface{}) (rangeLiteral, bool, error) {
+	switch value := value.(type) {
+	case nil:
+		return rangeLiteral{}, false, nil
+
+	case string:
+		rl, err := parseRangeLiteral(value)
+
+		return rl, err == nil, err
+
+	case []byte:
+		rl, err := parseRangeLiteral(string(value))
+
+		return rl, err == nil, err
+	}
+
+	return rangeLiteral{}, false, fmt.Errorf("unsupported type %T: %w", value, ErrInvalidRangeLiteral)
+}
+
+// parseRangeLiteral parses a PostgreSQL range literal such as "[2024-03-01,2024-04-01)" or "empty".
+// Both bounds may be inclusive or exclusive, and an empty or infinite bound is unbounded.
+// Exclusive bounds are converted to the adjacent inclusive dates, and a range without any dates is empty.
+func parseRangeLiteral(value string) (rangeLiteral, error) {
+	value = strings.TrimSpace(value)
+	if strings.EqualFold(value, "empty") {
+		return rangeLiteral{empty: true}, nil
+	}
+
+	invalid := fmt.Errorf("failed to parse %q: %w", value, ErrInvalidRangeLiteral)
+
+	if len(value) < 3 || !strings.ContainsRune("[(", rune(value[0])) || !strings.ContainsRune("])", rune(value[len(value)-1])) {
+		return rangeLiteral{}, invalid
+	}
+
+	lower, upper, found := strings.Cut(value[1:len(value)-1], ",")
+	if !found {
+		return rangeLiteral{}, invalid
+	}
+
+	start, err := parseRangeLiteralBound(lower, "-infinity")
+	if err != nil {
+		return rangeLiteral{}, fmt.Errorf("failed to parse lower bound: %w", err)
+	}
+
+	last, err := parseRangeLiteralBound(upper, "infinity")
+	if err != nil {
+		return rangeLiteral{}, fmt.Errorf("failed to parse upper bound: %w", err)
+	}
+
+	rl := rangeLiteral{
+		start:          start,
+		last:           last,
+		upperExclusive: value[len(value)-1] == ')',
+	}
+
+	if value[0] == '(' {
+		rl.start = rl.start.Map(Date.AddDay)
+	}
+	if rl.upperExclusive {
+		rl.last = rl.last.Map(Date.SubDay)
+	}
+
+	if rl.start.IsNotNull() && rl.last.IsNotNull() && rl.last.date.Before(rl.start.date) {
+		return rangeLiteral{empty: true}, nil
+	}
+
+	return rl, nil
+}
+
+// parseRangeLiteralBound parses a bound of a PostgreSQL range literal, which may be double-quoted.
+// It returns null if the bound is empty or equals the infinity keyword.
+func parseRangeLiteralBound(value, infinity string) (NullDate, error) {
+	value = strings.Trim(strings.TrimSpace(value), `"`)
+	if value == "" || strings.EqualFold(value, infinity) {
+		return NullDateForNull(), nil
+	}
+
+	d, err := Parse(value)
+	if err != nil {
+		return NullDateForNull(), err
+	}
+
+	return NullDateFromDate(d), nil
+}
+
+// formatRangeLiteral formats the inclusive bounds as a PostgreSQL range literal in the canonical form "[start,end)".
+// A null bound is formatted as unbounded.
+func formatRangeLiteral(start, last NullDate) string {
+	return "[" + formatOpenDateRangeSide(start, "") + "," + formatOpenDateRangeSide(last.Map(Date.AddDay), "") + ")"
+}
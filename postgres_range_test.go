@@ -0,0 +1,61 @@
+package date
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRangeLiteral(t *testing.T) {
+	tests := []struct {
+		value    string
+		want     string
+		wantErr  error
+		wantOpen bool
+	}{
+		{"[2024-03-01,2024-04-01)", "2024-03-01/2024-03-31", nil, true},
+		{"[2024-03-01,2024-03-31]", "2024-03-01/2024-03-31", nil, false},
+		{"(2024-02-29,2024-04-01)", "2024-03-01/2024-03-31", nil, true},
+		{"(2024-02-29,2024-03-31]", "2024-03-01/2024-03-31", nil, false},
+		{" [ 2024-03-01 , 2024-04-01 ) ", "2024-03-01/2024-03-31", nil, true},
+		{`["2024-03-01","2024-04-01")`, "2024-03-01/2024-03-31", nil, true},
+		{"[,2024-04-01)", "../2024-03-31", nil, true},
+		{"[-infinity,infinity]", "../..", nil, false},
+		{"EMPTY", "empty", nil, false},
+		{"(2024-03-01,2024-03-02)", "empty", nil, false},
+		{"[2024-03-01,2024-04-01", "", ErrInvalidRangeLiteral, false},
+		{"{2024-03-01,2024-04-01)", "", ErrInvalidRangeLiteral, false},
+		{"[2024-03-01]", "", ErrInvalidRangeLiteral, false},
+		{"[infinity,2024-04-01)", "", nil, false},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`parseRangeLiteral("%s")`, tt.value)
+
+		t.Run(testcase, func(t *testing.T) {
+			rl, err := parseRangeLiteral(tt.value)
+
+			if tt.want == "" {
+				assert.Error(t, err)
+				if tt.wantErr != nil {
+					assert.ErrorIs(t, err, tt.wantErr)
+				}
+
+				return
+			}
+
+			assert.Nil(t, err, "Expected no error, got %v", err)
+
+			if tt.want == "empty" {
+				assert.True(t, rl.empty)
+
+				return
+			}
+
+			assert.False(t, rl.empty)
+			assert.Equal(t, tt.want, OpenDateRange{start: rl.start, last: rl.last}.String())
+			assert.Equal(t, tt.wantOpen, rl.upperExclusive)
+		})
+	}
+}
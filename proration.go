@@ -0,0 +1,161 @@
+package date
+
+import (
+	"fmt"
+	"math/bits"
+	"sort"
+	"strconv"
+)
+
+// ProrationRounding specifies how the units left over by rounding down are distributed,
+// so that the prorated amounts always sum to the total.
+type ProrationRounding int
+
+const (
+	// LargestRemainder gives the leftover units one by one to the periods with the largest fractional parts,
+	// breaking ties in favour of the earlier period. It is also known as the Hamilton method.
+	LargestRemainder ProrationRounding = iota
+	// RemainderToFirst gives all leftover units to the first period.
+	RemainderToFirst
+	// RemainderToLast gives all leftover units to the last period.
+	RemainderToLast
+)
+
+// ProratedAmount is a part of an amount apportioned to a DateRange.
+type ProratedAmount struct {
+	dates  DateRange
+	amount int64
+}
+
+// ProratedAmounts is a slice of ProratedAmount in the order of the periods they were apportioned to.
+type ProratedAmounts []ProratedAmount
+
+// Factory functions
+// --------------------------------------------------
+
+// Prorate apportions the amount in minor currency units across the periods in proportion to their number of days.
+// Each part is rounded down and the leftover units are distributed according to the rounding,
+// so that the parts always sum to the amount. Negative amounts are apportioned symmetrically.
+// The periods are used as they are, even if they overlap.
+// It returns an error if the periods are empty.
+func Prorate(amount int64, periods DateRanges, rounding ProrationRounding) (ProratedAmounts, error) {
+	if len(periods) == 0 {
+		return nil, fmt.Errorf("Prorate: %w", ErrDateRangesAreEmpty)
+	}
+
+	days := make([]uint64, len(periods))
+	total := uint64(0)
+	for i, r := range periods {
+		days[i] = uint64(daysBetween(r.start, r.end) + 1)
+		total += days[i]
+	}
+
+	abs := uint64(amount)
+	if amount < 0 {
+		abs = uint64(-amount)
+	}
+
+	parts := make([]uint64, len(periods))
+	remainders := make([]uint64, len(periods))
+	leftover := abs
+
+	for i := range periods {
+		hi, lo := bits.Mul64(abs, days[i])
+		parts[i], remainders[i] = bits.Div64(hi, lo, total)
+		leftover -= parts[i]
+	}
+
+	switch rounding {
+	case RemainderToFirst:
+		parts[0] += leftover
+	case RemainderToLast:
+		parts[len(parts)-1] += leftover
+	default:
+		order := make([]int, len(periods))
+		for i := range order {
+			order[i] = i
+		}
+
+		sort.SliceStable(order, func(i, j int) bool {
+			return remainders[order[i]] > remainders[order[j]]
+		})
+
+		for _, i := range order[:leftover] {
+			parts[i]++
+		}
+	}
+
+	pas := make(ProratedAmounts, len(periods))
+	for i, r := range periods {
+		part := int64(parts[i])
+		if amount < 0 {
+			part = -part
+		}
+
+		pas[i] = ProratedAmount{r, part}
+	}
+
+	return pas, nil
+}
+
+// Conversion methods of DateRange
+// --------------------------------------------------
+
+// ProrateByMonth apportions the amount across the calendar months of the DateRange instance
+// in proportion to their number of days, clipping the first and last months to the range.
+func (r DateRange) ProrateByMonth(amount int64, rounding ProrationRounding) ProratedAmounts {
+	pas, _ := Prorate(amount, r.SplitByMonth(), rounding)
+
+	return pas
+}
+
+// Conversion methods of ProratedAmount
+// --------------------------------------------------
+
+// ToDateRange returns the period the amount is apportioned to.
+func (p ProratedAmount) ToDateRange() DateRange {
+	return p.dates
+}
+
+// Amount returns the apportioned amount in minor currency units.
+func (p ProratedAmount) Amount() int64 {
+	return p.amount
+}
+
+// String returns the string representation of the ProratedAmount instance in the format "start/end=amount".
+func (p ProratedAmount) String() string {
+	return p.dates.String() + "=" + strconv.FormatInt(p.amount, 10)
+}
+
+// Conversion methods of ProratedAmounts
+// --------------------------------------------------
+
+// Amounts returns the apportioned amounts in order.
+func (pas ProratedAmounts) Amounts() []int64 {
+	amounts := make([]int64, len(pas))
+	for i, p := range pas {
+		amounts[i] = p.amount
+	}
+
+	return amounts
+}
+
+// Sum returns the sum of the apportioned amounts, which equals the prorated amount.
+func (pas ProratedAmounts) Sum() int64 {
+	var sum int64
+	for _, p := range pas {
+		sum += p.amount
+	}
+
+	return sum
+}
+
+// Strings returns the string representations of the ProratedAmount instances.
+func (pas ProratedAmounts) Strings() []string {
+	strs := make([]string, len(pas))
+	for i, p := range pas {
+		strs[i] = p.String()
+	}
+
+	return strs
+}
@@ -0,0 +1,157 @@
+package date
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProrate(t *testing.T) {
+	tests := []struct {
+		amount   int64
+		periods  DateRanges
+		rounding ProrationRounding
+		want     []int64
+	}{
+		{
+			1000,
+			DateRanges{
+				MustParseDateRange("2024-06-01", "2024-06-10"),
+				MustParseDateRange("2024-06-11", "2024-06-30"),
+			},
+			LargestRemainder,
+			[]int64{333, 667},
+		},
+		{
+			1000,
+			DateRanges{
+				MustParseDateRange("2024-06-01", "2024-06-01"),
+				MustParseDateRange("2024-06-02", "2024-06-02"),
+				MustParseDateRange("2024-06-03", "2024-06-03"),
+			},
+			LargestRemainder,
+			[]int64{334, 333, 333},
+		},
+		{
+			1000,
+			DateRanges{
+				MustParseDateRange("2024-06-01", "2024-06-01"),
+				MustParseDateRange("2024-06-02", "2024-06-02"),
+				MustParseDateRange("2024-06-03", "2024-06-03"),
+			},
+			RemainderToLast,
+			[]int64{333, 333, 334},
+		},
+		{
+			2,
+			DateRanges{
+				MustParseDateRange("2024-06-01", "2024-06-01"),
+				MustParseDateRange("2024-06-02", "2024-06-02"),
+				MustParseDateRange("2024-06-03", "2024-06-03"),
+			},
+			RemainderToFirst,
+			[]int64{2, 0, 0},
+		},
+		{
+			-1000,
+			DateRanges{
+				MustParseDateRange("2024-06-01", "2024-06-01"),
+				MustParseDateRange("2024-06-02", "2024-06-02"),
+				MustParseDateRange("2024-06-03", "2024-06-03"),
+			},
+			LargestRemainder,
+			[]int64{-334, -333, -333},
+		},
+		{
+			0,
+			DateRanges{MustParseDateRange("2024-06-01", "2024-06-30")},
+			LargestRemainder,
+			[]int64{0},
+		},
+		{
+			math.MaxInt64,
+			DateRanges{
+				MustParseDateRange("2024-06-01", "2024-06-01"),
+				MustParseDateRange("2024-06-02", "2024-06-03"),
+			},
+			LargestRemainder,
+			[]int64{3074457345618258602, 6148914691236517205},
+		},
+		{
+			109573,
+			DateRanges{
+				MustParseDateRange("1700-01-01", "1999-12-31"),
+				MustParseDateRange("2000-01-01", "2000-01-01"),
+			},
+			LargestRemainder,
+			[]int64{109572, 1},
+		},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Prorate(%d, DateRanges{"%s"}, %d)`, tt.amount, strings.Join(tt.periods.Strings(), `","`), tt.rounding)
+
+		t.Run(testcase, func(t *testing.T) {
+			pas, err := Prorate(tt.amount, tt.periods, tt.rounding)
+			assert.Nil(t, err, "Expected no error, got %v", err)
+			assert.Equal(t, tt.want, pas.Amounts())
+			assert.Equal(t, tt.amount, pas.Sum())
+		})
+	}
+
+	t.Run("Prorate(1000, DateRanges{}, 0)", func(t *testing.T) {
+		pas, err := Prorate(1000, DateRanges{}, LargestRemainder)
+		assert.ErrorIs(t, err, ErrDateRangesAreEmpty)
+		assert.Nil(t, pas)
+	})
+}
+
+func TestDateRangeProrateByMonth(t *testing.T) {
+	tests := []struct {
+		dr       DateRange
+		amount   int64
+		rounding ProrationRounding
+		want     []string
+	}{
+		{
+			MustParseDateRange("2024-01-15", "2024-03-14"),
+			10000,
+			LargestRemainder,
+			[]string{"2024-01-15/2024-01-31=2834", "2024-02-01/2024-02-29=4833", "2024-03-01/2024-03-14=2333"},
+		},
+		{
+			MustParseDateRange("2024-01-15", "2024-03-14"),
+			10000,
+			RemainderToLast,
+			[]string{"2024-01-15/2024-01-31=2833", "2024-02-01/2024-02-29=4833", "2024-03-01/2024-03-14=2334"},
+		},
+		{
+			MustParseDateRange("2024-06-10", "2024-06-30"),
+			3000,
+			LargestRemainder,
+			[]string{"2024-06-10/2024-06-30=3000"},
+		},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`DateRange{"%s"}.ProrateByMonth(%d, %d)`, tt.dr, tt.amount, tt.rounding)
+
+		t.Run(testcase, func(t *testing.T) {
+			pas := tt.dr.ProrateByMonth(tt.amount, tt.rounding)
+			assert.Equal(t, tt.want, pas.Strings())
+			assert.Equal(t, tt.amount, pas.Sum())
+		})
+	}
+}
+
+func TestProratedAmountAccessors(t *testing.T) {
+	t.Run("ProratedAmount{}.ToDateRange() and Amount()", func(t *testing.T) {
+		pas, _ := Prorate(500, DateRanges{MustParseDateRange("2024-06-01", "2024-06-30")}, LargestRemainder)
+
+		assert.Equal(t, MustParseDateRange("2024-06-01", "2024-06-30"), pas[0].ToDateRange())
+		assert.Equal(t, int64(500), pas[0].Amount())
+	})
+}
@@ -0,0 +1,615 @@
+package date
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrEndQuarterIsBeforeStartQuarter = fmt.Errorf("end quarter is before start quarter")
+	ErrInvalidQuarter                 = fmt.Errorf("invalid quarter")
+	ErrInvalidFiscalQuarter           = fmt.Errorf("invalid fiscal quarter")
+)
+
+type Quarter struct {
+	y int // year -1
+	q int // quarter -1
+}
+
+// Factory functions
+// --------------------------------------------------
+
+// NewQuarter creates a new Quarter instance with the specified year and quarter.
+// If the quarter is out of the range of 1 to 4, it rolls over to the adjacent years.
+func NewQuarter(year, quarter int) Quarter {
+	return Quarter{year - 1, 0}.AddQuarters(quarter - 1)
+}
+
+// ZeroQuarter returns a zero value Quarter instance.
+func ZeroQuarter() Quarter {
+	return Quarter{}
+}
+
+// QuarterFromMonth creates a new Quarter instance from a Month instance.
+func QuarterFromMonth(month Month) Quarter {
+	return Quarter{month.y, month.m / 3}
+}
+
+// QuarterFromDate creates a new Quarter instance from a Date instance.
+func QuarterFromDate(date Date) Quarter {
+	return QuarterFromMonth(MonthFromDate(date))
+}
+
+// QuarterFromTime creates a new Quarter instance from a time.Time value.
+func QuarterFromTime(time time.Time) Quarter {
+	return QuarterFromMonth(MonthFromTime(time))
+}
+
+// ParseQuarter parses a quarter string in the format "2006-Q1" and returns a Quarter instance.
+func ParseQuarter(value string) (Quarter, error) {
+	year, quarter, ok := strings.Cut(value, "-Q")
+	if !ok || len(year) < 4 || len(quarter) != 1 {
+		return ZeroQuarter(), fmt.Errorf("ParseQuarter: failed to parse quarter %q: %w", value, ErrInvalidQuarter)
+	}
+
+	y, err := strconv.Atoi(year)
+	if err != nil {
+		return ZeroQuarter(), fmt.Errorf("ParseQuarter: failed to parse year of quarter %q: %w", value, ErrInvalidQuarter)
+	}
+
+	q, err := strconv.Atoi(quarter)
+	if err != nil || q < 1 || q > 4 {
+		return ZeroQuarter(), fmt.Errorf("ParseQuarter: failed to parse quarter of quarter %q: %w", value, ErrInvalidQuarter)
+	}
+
+	return Quarter{y - 1, q - 1}, nil
+}
+
+// MustParseQuarter parses a quarter string in the format "2006-Q1" and returns a Quarter instance.
+// It panics if the parsing fails.
+func MustParseQuarter(value string) Quarter {
+	q, err := ParseQuarter(value)
+	if err != nil {
+		panic(err)
+	}
+
+	return q
+}
+
+// CurrentQuarter returns the current quarter.
+func CurrentQuarter() Quarter {
+	return QuarterFromDate(Today())
+}
+
+// NextQuarter returns the next quarter.
+func NextQuarter() Quarter {
+	return CurrentQuarter().AddQuarter()
+}
+
+// LastQuarter returns the previous quarter.
+func LastQuarter() Quarter {
+	return CurrentQuarter().SubQuarter()
+}
+
+// Determination methods
+// --------------------------------------------------
+
+// IsZero checks if the Quarter instance is a zero value.
+func (q Quarter) IsZero() bool {
+	zq := ZeroQuarter()
+
+	return q.Equal(zq)
+}
+
+// IsPast checks if the Quarter instance is in the past.
+func (q Quarter) IsPast() bool {
+	return q.IsPastWith(DefaultClock())
+}
+
+// IsPastWith checks if the Quarter instance is in the past according to the specified Clock.
+func (q Quarter) IsPastWith(c Clock) bool {
+	return q.Before(QuarterFromDate(c.Today()))
+}
+
+// IsFuture checks if the Quarter instance is in the future.
+func (q Quarter) IsFuture() bool {
+	return q.IsFutureWith(DefaultClock())
+}
+
+// IsFutureWith checks if the Quarter instance is in the future according to the specified Clock.
+func (q Quarter) IsFutureWith(c Clock) bool {
+	return q.After(QuarterFromDate(c.Today()))
+}
+
+// IsCurrentQuarter checks if the Quarter instance is the current quarter.
+func (q Quarter) IsCurrentQuarter() bool {
+	return q.IsCurrentQuarterWith(DefaultClock())
+}
+
+// IsCurrentQuarterWith checks if the Quarter instance is the current quarter according to the specified Clock.
+func (q Quarter) IsCurrentQuarterWith(c Clock) bool {
+	return q.Equal(QuarterFromDate(c.Today()))
+}
+
+// IsNextQuarter checks if the Quarter instance is the next quarter.
+func (q Quarter) IsNextQuarter() bool {
+	return q.IsNextQuarterWith(DefaultClock())
+}
+
+// IsNextQuarterWith checks if the Quarter instance is the next quarter according to the specified Clock.
+func (q Quarter) IsNextQuarterWith(c Clock) bool {
+	return q.Equal(QuarterFromDate(c.Today()).AddQuarter())
+}
+
+// IsLastQuarter checks if the Quarter instance is the previous quarter.
+func (q Quarter) IsLastQuarter() bool {
+	return q.IsLastQuarterWith(DefaultClock())
+}
+
+// IsLastQuarterWith checks if the Quarter instance is the previous quarter according to the specified Clock.
+func (q Quarter) IsLastQuarterWith(c Clock) bool {
+	return q.Equal(QuarterFromDate(c.Today()).SubQuarter())
+}
+
+// Comparison methods
+// --------------------------------------------------
+
+// Compare compares the Quarter instance with another Quarter instance.
+// It returns 1 if the Quarter instance is after the other Quarter, 0 if they are equal, and -1 if it is before.
+func (q Quarter) Compare(quarter Quarter) int {
+	if q.After(quarter) {
+		return 1
+	} else if q.Equal(quarter) {
+		return 0
+	}
+
+	return -1
+}
+
+// Equal checks if the Quarter instance is equal to another Quarter instance.
+func (q Quarter) Equal(quarter Quarter) bool {
+	return q.y == quarter.y && q.q == quarter.q
+}
+
+// NotEqual checks if the Quarter instance is not equal to another Quarter instance.
+func (q Quarter) NotEqual(quarter Quarter) bool {
+	return !q.Equal(quarter)
+}
+
+// After checks if the Quarter instance is after another Quarter instance.
+func (q Quarter) After(quarter Quarter) bool {
+	return q.y > quarter.y ||
+		q.y == quarter.y && q.q > quarter.q
+}
+
+// AfterOrEqual checks if the Quarter instance is after or equal to another Quarter instance.
+func (q Quarter) AfterOrEqual(quarter Quarter) bool {
+	return q.Equal(quarter) || q.After(quarter)
+}
+
+// Before checks if the Quarter instance is before another Quarter instance.
+func (q Quarter) Before(quarter Quarter) bool {
+	return q.y < quarter.y ||
+		q.y == quarter.y && q.q < quarter.q
+}
+
+// BeforeOrEqual checks if the Quarter instance is before or equal to another Quarter instance.
+func (q Quarter) BeforeOrEqual(quarter Quarter) bool {
+	return q.Equal(quarter) || q.Before(quarter)
+}
+
+// Between checks if the Quarter instance is between two other Quarter instances.
+func (q Quarter) Between(start, end Quarter) (bool, error) {
+	if start.After(end) {
+		return false, fmt.Errorf(
+			"Between: end quarter %v is before start quarter %v: %w",
+			end,
+			start,
+			ErrEndQuarterIsBeforeStartQuarter,
+		)
+	}
+
+	return start.BeforeOrEqual(q) && end.AfterOrEqual(q), nil
+}
+
+// Addition and Subtraction methods
+// --------------------------------------------------
+
+// AddQuarter adds one quarter to the Quarter instance.
+func (q Quarter) AddQuarter() Quarter {
+	return q.AddQuarters(1)
+}
+
+// AddQuarters adds the specified number of quarters to the Quarter instance.
+func (q Quarter) AddQuarters(quarters int) Quarter {
+	qs := q.q + quarters
+	ty := q.y + qs/4
+	tq := qs % 4
+	if tq < 0 {
+		ty--
+		tq += 4
+	}
+
+	return Quarter{ty, tq}
+}
+
+// SubQuarter subtracts one quarter from the Quarter instance.
+func (q Quarter) SubQuarter() Quarter {
+	return q.SubQuarters(1)
+}
+
+// SubQuarters subtracts the specified number of quarters from the Quarter instance.
+func (q Quarter) SubQuarters(quarters int) Quarter {
+	return q.AddQuarters(quarters * -1)
+}
+
+// AddYear adds one year to the Quarter instance.
+func (q Quarter) AddYear() Quarter {
+	return q.AddYears(1)
+}
+
+// AddYears adds the specified number of years to the Quarter instance.
+func (q Quarter) AddYears(years int) Quarter {
+	return Quarter{
+		q.y + years,
+		q.q,
+	}
+}
+
+// SubYear subtracts one year from the Quarter instance.
+func (q Quarter) SubYear() Quarter {
+	return q.SubYears(1)
+}
+
+// SubYears subtracts the specified number of years from the Quarter instance.
+func (q Quarter) SubYears(years int) Quarter {
+	return q.AddYears(years * -1)
+}
+
+// Conversion methods
+// --------------------------------------------------
+
+// Year returns the year of the Quarter instance.
+func (q Quarter) Year() int {
+	return q.y + 1
+}
+
+// Quarter returns the quarter of the Quarter instance, from 1 to 4.
+func (q Quarter) Quarter() int {
+	return q.q + 1
+}
+
+// FirstMonth returns the first month of the Quarter instance.
+func (q Quarter) FirstMonth() Month {
+	return Month{q.y, q.q * 3}
+}
+
+// LastMonth returns the last month of the Quarter instance.
+func (q Quarter) LastMonth() Month {
+	return Month{q.y, q.q*3 + 2}
+}
+
+// Months returns the three months of the Quarter instance.
+func (q Quarter) Months() []Month {
+	first := q.FirstMonth()
+
+	return []Month{first, first.AddMonth(), first.AddMonths(2)}
+}
+
+// FirstDate returns the first date of the Quarter instance.
+func (q Quarter) FirstDate() Date {
+	return q.FirstMonth().FirstDate()
+}
+
+// LastDate returns the last date of the Quarter instance.
+func (q Quarter) LastDate() Date {
+	return q.LastMonth().LastDate()
+}
+
+// ToDateRange converts the Quarter instance to a DateRange instance.
+func (q Quarter) ToDateRange() DateRange {
+	r, _ := NewDateRange(q.FirstDate(), q.LastDate())
+
+	return r
+}
+
+// Days returns the number of days in the Quarter instance.
+func (q Quarter) Days() int {
+	return q.ToDateRange().Days()
+}
+
+// Dates returns the Dates within the Quarter instance.
+func (q Quarter) Dates() Dates {
+	return q.ToDateRange().Dates()
+}
+
+// Split splits the Quarter instance into year and quarter components.
+func (q Quarter) Split() (int, int) {
+	return q.Year(), q.Quarter()
+}
+
+// String returns the string representation of the Quarter instance in the format "2006-Q1".
+func (q Quarter) String() string {
+	f := "%04d-Q%d"
+	if q.Year() < 0 {
+		f = "%05d-Q%d"
+	}
+
+	return fmt.Sprintf(f, q.Year(), q.Quarter())
+}
+
+// Marshalling methods
+// --------------------------------------------------
+
+// MarshalText marshals the Quarter instance to a text representation.
+func (q *Quarter) MarshalText() ([]byte, error) {
+	return []byte(q.String()), nil
+}
+
+// UnmarshalText unmarshals a text representation into the Quarter instance.
+func (q *Quarter) UnmarshalText(text []byte) error {
+	quarter, err := ParseQuarter(string(text))
+	if err != nil {
+		return fmt.Errorf("Quarter.UnmarshalText: %w", err)
+	}
+
+	q.y, q.q = quarter.y, quarter.q
+
+	return nil
+}
+
+// MarshalJSON marshals the Quarter instance to a JSON representation.
+func (q Quarter) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + q.String() + `"`), nil
+}
+
+// UnmarshalJSON unmarshals a JSON representation into the Quarter instance.
+func (q *Quarter) UnmarshalJSON(json []byte) error {
+	value := strings.Trim(string(json), `"`)
+
+	quarter, err := ParseQuarter(value)
+	if err != nil {
+		return fmt.Errorf("Quarter.UnmarshalJSON: %w", err)
+	}
+
+	q.y, q.q = quarter.y, quarter.q
+
+	return nil
+}
+
+// FiscalQuarter is an immutable struct for a quarter of a fiscal year starting in an arbitrary month,
+// such as April for Japanese companies or October for the US government.
+// A fiscal year is labelled by the calendar year in which it starts, as in Japan (2024年度 starts in April 2024);
+// use EndYear for the label used by the US government (FY2025 starts in October 2024).
+// The zero value is the first quarter of the fiscal year 1 starting in January.
+type FiscalQuarter struct {
+	first Month
+	start int // start month -1
+}
+
+// Factory functions
+// --------------------------------------------------
+
+// NewFiscalQuarter creates a new FiscalQuarter instance with the specified fiscal year, quarter and the month in which fiscal years start.
+// If the quarter is out of the range of 1 to 4, it rolls over to the adjacent fiscal years.
+// A start month out of the range of January to December wraps around, so 0 is December and 13 is January.
+func NewFiscalQuarter(fiscalYear, quarter int, start time.Month) FiscalQuarter {
+	index := monthIndex(start)
+
+	return FiscalQuarter{
+		first: NewMonth(fiscalYear, time.Month(index+1)).AddMonths((quarter - 1) * 3),
+		start: index,
+	}
+}
+
+// FiscalQuarterFromMonth creates a new FiscalQuarter instance containing the specified Month.
+// The start month wraps around in the same way as NewFiscalQuarter.
+func FiscalQuarterFromMonth(month Month, start time.Month) FiscalQuarter {
+	index := monthIndex(start)
+	offset := (int(month.Month()) - 1 - index + 12) % 12
+
+	return FiscalQuarter{
+		first: month.SubMonths(offset % 3),
+		start: index,
+	}
+}
+
+// FiscalQuarterFromDate creates a new FiscalQuarter instance containing the specified Date.
+func FiscalQuarterFromDate(date Date, start time.Month) FiscalQuarter {
+	return FiscalQuarterFromMonth(MonthFromDate(date), start)
+}
+
+// ParseFiscalQuarter parses a fiscal quarter string in the format "FY2006-Q1" for fiscal years starting in the specified month.
+func ParseFiscalQuarter(value string, start time.Month) (FiscalQuarter, error) {
+	if start < time.January || start > time.December {
+		return FiscalQuarter{}, fmt.Errorf("ParseFiscalQuarter: invalid start month %d: %w", start, ErrInvalidFiscalQuarter)
+	}
+
+	label, ok := strings.CutPrefix(value, "FY")
+	if !ok {
+		return FiscalQuarter{}, fmt.Errorf("ParseFiscalQuarter: failed to parse fiscal quarter %q: %w", value, ErrInvalidFiscalQuarter)
+	}
+
+	q, err := ParseQuarter(label)
+	if err != nil {
+		return FiscalQuarter{}, fmt.Errorf("ParseFiscalQuarter: failed to parse fiscal quarter %q: %w", value, ErrInvalidFiscalQuarter)
+	}
+
+	return NewFiscalQuarter(q.Year(), q.Quarter(), start), nil
+}
+
+// MustParseFiscalQuarter parses a fiscal quarter string in the format "FY2006-Q1" and returns a FiscalQuarter instance.
+// It panics if the parsing fails.
+func MustParseFiscalQuarter(value string, start time.Month) FiscalQuarter {
+	q, err := ParseFiscalQuarter(value, start)
+	if err != nil {
+		panic(err)
+	}
+
+	return q
+}
+
+// parseFiscalQuarterCode parses the text representation of a FiscalQuarter in the format "FY2006-Q1@04",
+// where the number after "@" is the month in which fiscal years start.
+func parseFiscalQuarterCode(value string) (FiscalQuarter, error) {
+	label, month, ok := strings.Cut(value, "@")
+	if !ok || len(month) != 2 {
+		return FiscalQuarter{}, fmt.Errorf("failed to parse fiscal quarter %q: %w", value, ErrInvalidFiscalQuarter)
+	}
+
+	start, err := strconv.Atoi(month)
+	if err != nil {
+		return FiscalQuarter{}, fmt.Errorf("failed to parse start month of fiscal quarter %q: %w", value, ErrInvalidFiscalQuarter)
+	}
+
+	return ParseFiscalQuarter(label, time.Month(start))
+}
+
+// monthIndex returns the index of the specified month from 0 for January to 11 for December.
+// Months out of the range of January to December wrap around.
+func monthIndex(month time.Month) int {
+	return ((int(month)-1)%12 + 12) % 12
+}
+
+// Comparison methods
+// --------------------------------------------------
+
+// Equal checks if the FiscalQuarter instance is equal to another FiscalQuarter instance.
+func (q FiscalQuarter) Equal(quarter FiscalQuarter) bool {
+	return q.first.Equal(quarter.first) && q.start == quarter.start
+}
+
+// After checks if the FiscalQuarter instance starts after another FiscalQuarter instance.
+func (q FiscalQuarter) After(quarter FiscalQuarter) bool {
+	return q.first.After(quarter.first)
+}
+
+// Before checks if the FiscalQuarter instance starts before another FiscalQuarter instance.
+func (q FiscalQuarter) Before(quarter FiscalQuarter) bool {
+	return q.first.Before(quarter.first)
+}
+
+// Addition and Subtraction methods
+// --------------------------------------------------
+
+// AddQuarters adds the specified number of quarters to the FiscalQuarter instance.
+func (q FiscalQuarter) AddQuarters(quarters int) FiscalQuarter {
+	return FiscalQuarter{
+		first: q.first.AddMonths(quarters * 3),
+		start: q.start,
+	}
+}
+
+// SubQuarters subtracts the specified number of quarters from the FiscalQuarter instance.
+func (q FiscalQuarter) SubQuarters(quarters int) FiscalQuarter {
+	return q.AddQuarters(quarters * -1)
+}
+
+// Conversion methods
+// --------------------------------------------------
+
+// FiscalYear returns the fiscal year of the FiscalQuarter instance, which is the calendar year in which the fiscal year starts.
+func (q FiscalQuarter) FiscalYear() int {
+	return q.first.SubMonths((q.Quarter() - 1) * 3).Year()
+}
+
+// EndYear returns the calendar year in which the fiscal year of the FiscalQuarter instance ends.
+func (q FiscalQuarter) EndYear() int {
+	return q.first.SubMonths((q.Quarter() - 1) * 3).AddMonths(11).Year()
+}
+
+// Quarter returns the quarter of the FiscalQuarter instance within its fiscal year, from 1 to 4.
+func (q FiscalQuarter) Quarter() int {
+	return (int(q.first.Month())-1-q.start+12)%12/3 + 1
+}
+
+// StartMonth returns the month in which fiscal years start.
+func (q FiscalQuarter) StartMonth() time.Month {
+	return time.Month(q.start + 1)
+}
+
+// FiscalCalendar returns the month-based FiscalCalendar of the FiscalQuarter instance.
+func (q FiscalQuarter) FiscalCalendar() FiscalCalendar {
+	return NewFiscalCalendar(q.StartMonth())
+}
+
+// FirstMonth returns the first month of the FiscalQuarter instance.
+func (q FiscalQuarter) FirstMonth() Month {
+	return q.first
+}
+
+// LastMonth returns the last month of the FiscalQuarter instance.
+func (q FiscalQuarter) LastMonth() Month {
+	return q.first.AddMonths(2)
+}
+
+// Months returns the three months of the FiscalQuarter instance.
+func (q FiscalQuarter) Months() []Month {
+	return []Month{q.first, q.first.AddMonth(), q.first.AddMonths(2)}
+}
+
+// FirstDate returns the first date of the FiscalQuarter instance.
+func (q FiscalQuarter) FirstDate() Date {
+	return q.first.FirstDate()
+}
+
+// LastDate returns the last date of the FiscalQuarter instance.
+func (q FiscalQuarter) LastDate() Date {
+	return q.LastMonth().LastDate()
+}
+
+// ToDateRange converts the FiscalQuarter instance to a DateRange instance.
+func (q FiscalQuarter) ToDateRange() DateRange {
+	r, _ := NewDateRange(q.FirstDate(), q.LastDate())
+
+	return r
+}
+
+// String returns the string representation of the FiscalQuarter instance in the format "FY2006-Q1".
+func (q FiscalQuarter) String() string {
+	return fmt.Sprintf("FY%04d-Q%d", q.FiscalYear(), q.Quarter())
+}
+
+// Marshalling methods
+// --------------------------------------------------
+
+// MarshalText marshals the FiscalQuarter instance to a text representation in the format "FY2006-Q1@04".
+// The start month is appended because String does not contain it.
+func (q *FiscalQuarter) MarshalText() ([]byte, error) {
+	return []byte(fmt.Sprintf("%s@%02d", q.String(), q.StartMonth())), nil
+}
+
+// UnmarshalText unmarshals a text representation in the format "FY2006-Q1@04" into the FiscalQuarter instance.
+func (q *FiscalQuarter) UnmarshalText(text []byte) error {
+	quarter, err := parseFiscalQuarterCode(string(text))
+	if err != nil {
+		return fmt.Errorf("FiscalQuarter.UnmarshalText: %w", err)
+	}
+
+	*q = quarter
+
+	return nil
+}
+
+// MarshalJSON marshals the FiscalQuarter instance to a JSON representation in the format "FY2006-Q1@04".
+func (q FiscalQuarter) MarshalJSON() ([]byte, error) {
+	text, _ := q.MarshalText()
+
+	return []byte(`"` + string(text) + `"`), nil
+}
+
+// UnmarshalJSON unmarshals a JSON representation into the FiscalQuarter instance.
+func (q *FiscalQuarter) UnmarshalJSON(json []byte) error {
+	value := strings.Trim(string(json), `"`)
+
+	quarter, err := parseFiscalQuarterCode(value)
+	if err != nil {
+		return fmt.Errorf("FiscalQuarter.UnmarshalJSON: %w", err)
+	}
+
+	*q = quarter
+
+	return nil
+}
@@ -0,0 +1,860 @@
+package date
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Factory functions
+// --------------------------------------------------
+
+func TestNewQuarter(t *testing.T) {
+	tests := []struct {
+		year    int
+		quarter int
+		want    string
+	}{
+		{2024, 2, "2024-Q2"},
+		{2024, 5, "2025-Q1"},
+		{2024, 0, "2023-Q4"},
+		{2024, -4, "2022-Q4"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf("NewQuarter(%d, %d)", tt.year, tt.quarter)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, NewQuarter(tt.year, tt.quarter).String())
+		})
+	}
+}
+
+func TestZeroQuarter(t *testing.T) {
+	t.Run("ZeroQuarter()", func(t *testing.T) {
+		quarter := ZeroQuarter()
+		assert.True(t, quarter.IsZero(), "Quarter created was not zero value")
+		assert.Equal(t, "0001-Q1", quarter.String())
+		assert.Equal(t, "0001-01-01", quarter.FirstDate().String())
+	})
+}
+
+func TestQuarterFromMonth(t *testing.T) {
+	tests := []struct {
+		month Month
+		want  string
+	}{
+		{MustParseMonth("2024-01"), "2024-Q1"},
+		{MustParseMonth("2024-03"), "2024-Q1"},
+		{MustParseMonth("2024-04"), "2024-Q2"},
+		{MustParseMonth("2024-12"), "2024-Q4"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`QuarterFromMonth(Month{"%s"})`, tt.month)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, QuarterFromMonth(tt.month).String())
+		})
+	}
+}
+
+func TestQuarterFromDate(t *testing.T) {
+	tests := []struct {
+		date Date
+		want string
+	}{
+		{MustParse("2024-06-30"), "2024-Q2"},
+		{MustParse("2024-07-01"), "2024-Q3"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`QuarterFromDate(Date{"%s"})`, tt.date)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, QuarterFromDate(tt.date).String())
+		})
+	}
+}
+
+func TestQuarterFromTime(t *testing.T) {
+	tests := []struct {
+		time time.Time
+		want string
+	}{
+		{time.Date(2024, time.May, 11, 0, 0, 0, 0, time.UTC), "2024-Q2"},
+		{time.Date(2024, time.December, 31, 23, 59, 59, 0, time.Local), "2024-Q4"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`QuarterFromTime(Time{%s})`, tt.time.Format(iso8601))
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, QuarterFromTime(tt.time).String())
+		})
+	}
+}
+
+func TestParseQuarter(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    string
+		wantErr error
+	}{
+		{"2024-Q1", "2024-Q1", nil},
+		{"2024-Q4", "2024-Q4", nil},
+		{"2024-Q0", "", ErrInvalidQuarter},
+		{"2024-Q5", "", ErrInvalidQuarter},
+		{"2024-02", "", ErrInvalidQuarter},
+		{"24-Q1", "", ErrInvalidQuarter},
+		{"abcd-Q1", "", ErrInvalidQuarter},
+		{"", "", ErrInvalidQuarter},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`ParseQuarter("%s")`, tt.value)
+
+		t.Run(testcase, func(t *testing.T) {
+			q, err := ParseQuarter(tt.value)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.True(t, q.IsZero(), "quarter is not zero")
+			} else {
+				assert.Nil(t, err, "Expected no error, got %v", err)
+				assert.Equal(t, tt.want, q.String())
+			}
+		})
+	}
+}
+
+func TestMustParseQuarter(t *testing.T) {
+	tests := []struct {
+		value     string
+		wantPanic bool
+	}{
+		{"2024-Q2", false},
+		{"2024-Q5", true},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`MustParseQuarter("%s")`, tt.value)
+
+		t.Run(testcase, func(t *testing.T) {
+			if tt.wantPanic {
+				assert.Panics(t, func() { MustParseQuarter(tt.value) })
+			} else {
+				assert.Equal(t, tt.value, MustParseQuarter(tt.value).String())
+			}
+		})
+	}
+}
+
+func TestCurrentQuarter(t *testing.T) {
+	tests := []struct {
+		now  time.Time
+		want string
+	}{
+		{time.Date(2024, time.April, 1, 0, 0, 0, 0, time.Local), "2024-Q2"},
+		{time.Date(2024, time.March, 31, 23, 59, 59, 999, time.Local), "2024-Q1"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`CurrentQuarter() at %s`, tt.now.Format(iso8601))
+
+		t.Run(testcase, func(t *testing.T) {
+			SetTestNow(func() time.Time { return tt.now })
+			defer ResetTestNow()
+
+			assert.Equal(t, tt.want, CurrentQuarter().String())
+		})
+	}
+}
+
+func TestNextQuarter(t *testing.T) {
+	t.Run("NextQuarter()", func(t *testing.T) {
+		SetTestNow(func() time.Time { return time.Date(2024, time.November, 15, 0, 0, 0, 0, time.Local) })
+		defer ResetTestNow()
+
+		assert.Equal(t, "2025-Q1", NextQuarter().String())
+	})
+}
+
+func TestLastQuarter(t *testing.T) {
+	t.Run("LastQuarter()", func(t *testing.T) {
+		SetTestNow(func() time.Time { return time.Date(2024, time.February, 15, 0, 0, 0, 0, time.Local) })
+		defer ResetTestNow()
+
+		assert.Equal(t, "2023-Q4", LastQuarter().String())
+	})
+}
+
+// Determination methods
+// --------------------------------------------------
+
+func TestQuarterIsZero(t *testing.T) {
+	tests := []struct {
+		quarter Quarter
+		want    bool
+	}{
+		{ZeroQuarter(), true},
+		{MustParseQuarter("2024-Q2"), false},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Quarter{"%s"}.IsZero()`, tt.quarter)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.quarter.IsZero())
+		})
+	}
+}
+
+func TestQuarterRelativeDeterminations(t *testing.T) {
+	tests := []struct {
+		quarter                           Quarter
+		past, future, current, next, last bool
+	}{
+		{MustParseQuarter("2024-Q1"), true, false, false, false, true},
+		{MustParseQuarter("2024-Q2"), false, false, true, false, false},
+		{MustParseQuarter("2024-Q3"), false, true, false, true, false},
+		{MustParseQuarter("2024-Q4"), false, true, false, false, false},
+	}
+
+	for _, tt := range tests {
+		SetTestNow(func() time.Time { return time.Date(2024, time.May, 13, 12, 0, 0, 0, time.Local) })
+		defer ResetTestNow()
+
+		testcase := fmt.Sprintf(`Quarter{"%s"} at %s`, tt.quarter, now().Format(iso8601))
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.past, tt.quarter.IsPast(), "IsPast")
+			assert.Equal(t, tt.future, tt.quarter.IsFuture(), "IsFuture")
+			assert.Equal(t, tt.current, tt.quarter.IsCurrentQuarter(), "IsCurrentQuarter")
+			assert.Equal(t, tt.next, tt.quarter.IsNextQuarter(), "IsNextQuarter")
+			assert.Equal(t, tt.last, tt.quarter.IsLastQuarter(), "IsLastQuarter")
+		})
+	}
+}
+
+// Comparison methods
+// --------------------------------------------------
+
+func TestQuarterComparisons(t *testing.T) {
+	tests := []struct {
+		quarter, target                                          Quarter
+		compare                                                  int
+		equal, after, afterOrEqual, before, beforeOrEqual, notEq bool
+	}{
+		{MustParseQuarter("2024-Q2"), MustParseQuarter("2024-Q2"), 0, true, false, true, false, true, false},
+		{MustParseQuarter("2024-Q2"), MustParseQuarter("2024-Q1"), 1, false, true, true, false, false, true},
+		{MustParseQuarter("2024-Q2"), MustParseQuarter("2024-Q3"), -1, false, false, false, true, true, true},
+		{MustParseQuarter("2024-Q1"), MustParseQuarter("2023-Q4"), 1, false, true, true, false, false, true},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Quarter{"%s"} vs Quarter{"%s"}`, tt.quarter, tt.target)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.compare, tt.quarter.Compare(tt.target), "Compare")
+			assert.Equal(t, tt.equal, tt.quarter.Equal(tt.target), "Equal")
+			assert.Equal(t, tt.notEq, tt.quarter.NotEqual(tt.target), "NotEqual")
+			assert.Equal(t, tt.after, tt.quarter.After(tt.target), "After")
+			assert.Equal(t, tt.afterOrEqual, tt.quarter.AfterOrEqual(tt.target), "AfterOrEqual")
+			assert.Equal(t, tt.before, tt.quarter.Before(tt.target), "Before")
+			assert.Equal(t, tt.beforeOrEqual, tt.quarter.BeforeOrEqual(tt.target), "BeforeOrEqual")
+		})
+	}
+}
+
+func TestQuarterBetween(t *testing.T) {
+	tests := []struct {
+		quarter Quarter
+		start   Quarter
+		end     Quarter
+		want    bool
+		wantErr error
+	}{
+		{MustParseQuarter("2024-Q2"), MustParseQuarter("2024-Q1"), MustParseQuarter("2024-Q3"), true, nil},
+		{MustParseQuarter("2024-Q2"), MustParseQuarter("2024-Q2"), MustParseQuarter("2024-Q2"), true, nil},
+		{MustParseQuarter("2024-Q2"), MustParseQuarter("2024-Q3"), MustParseQuarter("2024-Q4"), false, nil},
+		{MustParseQuarter("2024-Q2"), MustParseQuarter("2024-Q3"), MustParseQuarter("2024-Q1"), false, ErrEndQuarterIsBeforeStartQuarter},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Quarter{"%s"}.Between(Quarter{"%s"},Quarter{"%s"})`, tt.quarter, tt.start, tt.end)
+
+		t.Run(testcase, func(t *testing.T) {
+			b, err := tt.quarter.Between(tt.start, tt.end)
+			assert.Equal(t, tt.want, b)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.Nil(t, err, "Expected no error, got %v", err)
+			}
+		})
+	}
+}
+
+// Addition and Subtraction methods
+// --------------------------------------------------
+
+func TestQuarterAddQuarter(t *testing.T) {
+	tests := []struct {
+		quarter Quarter
+		want    string
+	}{
+		{MustParseQuarter("2024-Q2"), "2024-Q3"},
+		{MustParseQuarter("2024-Q4"), "2025-Q1"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Quarter{"%s"}.AddQuarter()`, tt.quarter)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.quarter.AddQuarter().String())
+		})
+	}
+}
+
+func TestQuarterAddQuarters(t *testing.T) {
+	tests := []struct {
+		quarter  Quarter
+		quarters int
+		want     string
+	}{
+		{MustParseQuarter("2024-Q2"), 0, "2024-Q2"},
+		{MustParseQuarter("2024-Q2"), 3, "2025-Q1"},
+		{MustParseQuarter("2024-Q2"), 10, "2026-Q4"},
+		{MustParseQuarter("2024-Q2"), -2, "2023-Q4"},
+		{MustParseQuarter("2024-Q2"), -5, "2023-Q1"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Quarter{"%s"}.AddQuarters(%d)`, tt.quarter, tt.quarters)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.quarter.AddQuarters(tt.quarters).String())
+		})
+	}
+}
+
+func TestQuarterSubQuarter(t *testing.T) {
+	tests := []struct {
+		quarter Quarter
+		want    string
+	}{
+		{MustParseQuarter("2024-Q2"), "2024-Q1"},
+		{MustParseQuarter("2024-Q1"), "2023-Q4"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Quarter{"%s"}.SubQuarter()`, tt.quarter)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.quarter.SubQuarter().String())
+		})
+	}
+}
+
+func TestQuarterSubQuarters(t *testing.T) {
+	tests := []struct {
+		quarter  Quarter
+		quarters int
+		want     string
+	}{
+		{MustParseQuarter("2024-Q2"), 5, "2023-Q1"},
+		{MustParseQuarter("2024-Q2"), -2, "2024-Q4"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Quarter{"%s"}.SubQuarters(%d)`, tt.quarter, tt.quarters)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.quarter.SubQuarters(tt.quarters).String())
+		})
+	}
+}
+
+func TestQuarterAddAndSubYears(t *testing.T) {
+	q := MustParseQuarter("2024-Q3")
+
+	t.Run(`Quarter{"2024-Q3"}.AddYear()`, func(t *testing.T) {
+		assert.Equal(t, "2025-Q3", q.AddYear().String())
+	})
+	t.Run(`Quarter{"2024-Q3"}.AddYears(2)`, func(t *testing.T) {
+		assert.Equal(t, "2026-Q3", q.AddYears(2).String())
+	})
+	t.Run(`Quarter{"2024-Q3"}.SubYear()`, func(t *testing.T) {
+		assert.Equal(t, "2023-Q3", q.SubYear().String())
+	})
+	t.Run(`Quarter{"2024-Q3"}.SubYears(2)`, func(t *testing.T) {
+		assert.Equal(t, "2022-Q3", q.SubYears(2).String())
+	})
+}
+
+// Conversion methods
+// --------------------------------------------------
+
+func TestQuarterYearAndQuarter(t *testing.T) {
+	tests := []struct {
+		quarter Quarter
+		year    int
+		number  int
+	}{
+		{MustParseQuarter("2024-Q2"), 2024, 2},
+		{ZeroQuarter(), 1, 1},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Quarter{"%s"}.Split()`, tt.quarter)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.year, tt.quarter.Year(), "Year")
+			assert.Equal(t, tt.number, tt.quarter.Quarter(), "Quarter")
+
+			year, number := tt.quarter.Split()
+			assert.Equal(t, tt.year, year, "Split")
+			assert.Equal(t, tt.number, number, "Split")
+		})
+	}
+}
+
+func TestQuarterMonths(t *testing.T) {
+	tests := []struct {
+		quarter     Quarter
+		first, last string
+		months      []string
+	}{
+		{MustParseQuarter("2024-Q1"), "2024-01", "2024-03", []string{"2024-01", "2024-02", "2024-03"}},
+		{MustParseQuarter("2024-Q4"), "2024-10", "2024-12", []string{"2024-10", "2024-11", "2024-12"}},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Quarter{"%s"}.Months()`, tt.quarter)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.first, tt.quarter.FirstMonth().String(), "FirstMonth")
+			assert.Equal(t, tt.last, tt.quarter.LastMonth().String(), "LastMonth")
+
+			months := make([]string, 0)
+			for _, m := range tt.quarter.Months() {
+				months = append(months, m.String())
+			}
+			assert.Equal(t, tt.months, months, "Months")
+		})
+	}
+}
+
+func TestQuarterFirstDateAndLastDate(t *testing.T) {
+	tests := []struct {
+		quarter     Quarter
+		first, last string
+	}{
+		{MustParseQuarter("2024-Q1"), "2024-01-01", "2024-03-31"},
+		{MustParseQuarter("2024-Q2"), "2024-04-01", "2024-06-30"},
+		{MustParseQuarter("2024-Q4"), "2024-10-01", "2024-12-31"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Quarter{"%s"}.FirstDate() and LastDate()`, tt.quarter)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.first, tt.quarter.FirstDate().String(), "FirstDate")
+			assert.Equal(t, tt.last, tt.quarter.LastDate().String(), "LastDate")
+		})
+	}
+}
+
+func TestQuarterToDateRange(t *testing.T) {
+	t.Run(`Quarter{"2024-Q2"}.ToDateRange()`, func(t *testing.T) {
+		assert.Equal(t, "2024-04-01/2024-06-30", MustParseQuarter("2024-Q2").ToDateRange().String())
+	})
+}
+
+func TestQuarterDays(t *testing.T) {
+	tests := []struct {
+		quarter Quarter
+		want    int
+	}{
+		{MustParseQuarter("2023-Q1"), 90},
+		{MustParseQuarter("2024-Q1"), 91},
+		{MustParseQuarter("2024-Q3"), 92},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Quarter{"%s"}.Days()`, tt.quarter)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.quarter.Days())
+		})
+	}
+}
+
+func TestQuarterDates(t *testing.T) {
+	t.Run(`Quarter{"2024-Q1"}.Dates()`, func(t *testing.T) {
+		dates := MustParseQuarter("2024-Q1").Dates()
+
+		assert.Len(t, dates, 91)
+		assert.Equal(t, "2024-01-01", dates[0].String())
+		assert.Equal(t, "2024-03-31", dates[90].String())
+	})
+}
+
+func TestQuarterString(t *testing.T) {
+	tests := []struct {
+		quarter Quarter
+		want    string
+	}{
+		{MustParseQuarter("2024-Q1"), "2024-Q1"},
+		{NewQuarter(-1, 3), "-0001-Q3"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Quarter{%d,%d}.String()`, tt.quarter.Year(), tt.quarter.Quarter())
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.quarter.String())
+		})
+	}
+}
+
+func TestDateToQuarter(t *testing.T) {
+	tests := []struct {
+		date Date
+		want Quarter
+	}{
+		{MustParse("2024-05-13"), MustParseQuarter("2024-Q2")},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Date{"%s"}.ToQuarter()`, tt.date)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.date.ToQuarter())
+		})
+	}
+}
+
+func TestMonthToQuarter(t *testing.T) {
+	tests := []struct {
+		month Month
+		want  Quarter
+	}{
+		{MustParseMonth("2024-09"), MustParseQuarter("2024-Q3")},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Month{"%s"}.ToQuarter()`, tt.month)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.month.ToQuarter())
+		})
+	}
+}
+
+// Marshalling methods
+// --------------------------------------------------
+
+func TestQuarterMarshalText(t *testing.T) {
+	tests := []struct {
+		quarter Quarter
+		want    string
+	}{
+		{MustParseQuarter("2024-Q2"), "2024-Q2"},
+		{ZeroQuarter(), "0001-Q1"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Quarter{"%s"}.MarshalText()`, tt.quarter)
+
+		t.Run(testcase, func(t *testing.T) {
+			text, err := tt.quarter.MarshalText()
+			assert.Nil(t, err, "Excepted no error, got %v", err)
+			assert.Equal(t, tt.want, string(text))
+		})
+	}
+}
+
+func TestQuarterUnmarshalText(t *testing.T) {
+	tests := []struct {
+		text []byte
+		want string
+	}{
+		{[]byte("2024-Q2"), "2024-Q2"},
+		{[]byte{}, "error"},
+		{[]byte("invalid"), "error"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Quarter{}.UnmarshalText("%s")`, tt.text)
+
+		t.Run(testcase, func(t *testing.T) {
+			q := ZeroQuarter()
+			err := q.UnmarshalText(tt.text)
+			if tt.want == "error" {
+				assert.Error(t, err, "Unable to parse")
+				assert.True(t, q.IsZero(), "quarter is not zero")
+			} else {
+				assert.Nil(t, err, "Expected no error, got %v", err)
+				assert.Equal(t, tt.want, q.String())
+			}
+		})
+	}
+}
+
+func TestQuarterMarshalJSON(t *testing.T) {
+	tests := []struct {
+		quarter Quarter
+		want    string
+	}{
+		{MustParseQuarter("2024-Q2"), `"2024-Q2"`},
+		{ZeroQuarter(), `"0001-Q1"`},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Quarter{"%s"}.MarshalJSON()`, tt.quarter)
+
+		t.Run(testcase, func(t *testing.T) {
+			json, err := tt.quarter.MarshalJSON()
+			assert.Nil(t, err, "Excepted no error, got %v", err)
+			assert.Equal(t, tt.want, string(json))
+		})
+	}
+}
+
+func TestQuarterUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		json []byte
+		want string
+	}{
+		{[]byte(`"2024-Q2"`), "2024-Q2"},
+		{[]byte{}, "error"},
+		{[]byte("invalid"), "error"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf("Quarter{}.UnmarshalJSON(`%s`)", tt.json)
+
+		t.Run(testcase, func(t *testing.T) {
+			q := ZeroQuarter()
+			err := q.UnmarshalJSON(tt.json)
+			if tt.want == "error" {
+				assert.Error(t, err, "Unable to parse")
+				assert.True(t, q.IsZero(), "quarter is not zero")
+			} else {
+				assert.Nil(t, err, "Expected no error, got %v", err)
+				assert.Equal(t, tt.want, q.String())
+			}
+		})
+	}
+}
+
+// FiscalQuarter
+// --------------------------------------------------
+
+func TestNewFiscalQuarter(t *testing.T) {
+	tests := []struct {
+		fiscalYear int
+		quarter    int
+		start      time.Month
+		want       string
+		first      string
+		last       string
+	}{
+		{2024, 1, time.April, "FY2024-Q1", "2024-04-01", "2024-06-30"},
+		{2024, 4, time.April, "FY2024-Q4", "2025-01-01", "2025-03-31"},
+		{2024, 5, time.April, "FY2025-Q1", "2025-04-01", "2025-06-30"},
+		{2024, 1, time.October, "FY2024-Q1", "2024-10-01", "2024-12-31"},
+		{2024, 2, time.January, "FY2024-Q2", "2024-04-01", "2024-06-30"},
+		{2024, 1, time.Month(0), "FY2024-Q1", "2024-12-01", "2025-02-28"},
+		{2024, 1, time.Month(13), "FY2024-Q1", "2024-01-01", "2024-03-31"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf("NewFiscalQuarter(%d, %d, %s)", tt.fiscalYear, tt.quarter, tt.start)
+
+		t.Run(testcase, func(t *testing.T) {
+			q := NewFiscalQuarter(tt.fiscalYear, tt.quarter, tt.start)
+			assert.Equal(t, tt.want, q.String())
+			assert.Equal(t, tt.first, q.FirstDate().String(), "FirstDate")
+			assert.Equal(t, tt.last, q.LastDate().String(), "LastDate")
+		})
+	}
+}
+
+func TestFiscalQuarterFromMonth(t *testing.T) {
+	tests := []struct {
+		month      Month
+		start      time.Month
+		fiscalYear int
+		endYear    int
+		quarter    int
+	}{
+		{MustParseMonth("2024-04"), time.April, 2024, 2025, 1},
+		{MustParseMonth("2024-12"), time.April, 2024, 2025, 3},
+		{MustParseMonth("2025-03"), time.April, 2024, 2025, 4},
+		{MustParseMonth("2024-10"), time.October, 2024, 2025, 1},
+		{MustParseMonth("2024-09"), time.October, 2023, 2024, 4},
+		{MustParseMonth("2024-05"), time.January, 2024, 2024, 2},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`FiscalQuarterFromMonth(Month{"%s"}, %s)`, tt.month, tt.start)
+
+		t.Run(testcase, func(t *testing.T) {
+			q := FiscalQuarterFromMonth(tt.month, tt.start)
+			assert.Equal(t, tt.fiscalYear, q.FiscalYear(), "FiscalYear")
+			assert.Equal(t, tt.endYear, q.EndYear(), "EndYear")
+			assert.Equal(t, tt.quarter, q.Quarter(), "Quarter")
+			assert.Equal(t, tt.start, q.StartMonth(), "StartMonth")
+		})
+	}
+}
+
+func TestFiscalQuarterFromDate(t *testing.T) {
+	tests := []struct {
+		date  Date
+		start time.Month
+		want  string
+	}{
+		{MustParse("2024-03-31"), time.April, "FY2023-Q4"},
+		{MustParse("2024-04-01"), time.April, "FY2024-Q1"},
+		{MustParse("2024-11-15"), time.October, "FY2024-Q1"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`FiscalQuarterFromDate(Date{"%s"}, %s)`, tt.date, tt.start)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, FiscalQuarterFromDate(tt.date, tt.start).String())
+		})
+	}
+}
+
+func TestFiscalQuarterComparisons(t *testing.T) {
+	q1 := NewFiscalQuarter(2024, 1, time.April)
+	q2 := NewFiscalQuarter(2024, 2, time.April)
+
+	t.Run("FiscalQuarter comparisons", func(t *testing.T) {
+		assert.True(t, q1.Equal(FiscalQuarterFromDate(MustParse("2024-05-01"), time.April)), "Equal")
+		assert.False(t, q1.Equal(NewFiscalQuarter(2024, 2, time.January)), "Equal with different start month")
+		assert.True(t, q2.After(q1), "After")
+		assert.True(t, q1.Before(q2), "Before")
+	})
+}
+
+func TestFiscalQuarterAddAndSubQuarters(t *testing.T) {
+	tests := []struct {
+		quarter  FiscalQuarter
+		quarters int
+		want     string
+	}{
+		{NewFiscalQuarter(2024, 4, time.April), 1, "FY2025-Q1"},
+		{NewFiscalQuarter(2024, 1, time.April), -1, "FY2023-Q4"},
+		{NewFiscalQuarter(2024, 2, time.October), 6, "FY2025-Q4"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`FiscalQuarter{"%s"}.AddQuarters(%d)`, tt.quarter, tt.quarters)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.quarter.AddQuarters(tt.quarters).String(), "AddQuarters")
+			assert.Equal(t, tt.want, tt.quarter.SubQuarters(-tt.quarters).String(), "SubQuarters")
+		})
+	}
+}
+
+func TestFiscalQuarterMonths(t *testing.T) {
+	t.Run(`FiscalQuarter{"FY2024-Q4"}.Months()`, func(t *testing.T) {
+		q := NewFiscalQuarter(2024, 4, time.April)
+
+		months := make([]string, 0)
+		for _, m := range q.Months() {
+			months = append(months, m.String())
+		}
+		assert.Equal(t, []string{"2025-01", "2025-02", "2025-03"}, months)
+		assert.Equal(t, "2025-01", q.FirstMonth().String(), "FirstMonth")
+		assert.Equal(t, "2025-03", q.LastMonth().String(), "LastMonth")
+		assert.Equal(t, "2025-01-01/2025-03-31", q.ToDateRange().String(), "ToDateRange")
+	})
+}
+
+func TestParseFiscalQuarter(t *testing.T) {
+	tests := []struct {
+		value   string
+		start   time.Month
+		want    string
+		wantErr bool
+	}{
+		{"FY2024-Q1", time.April, "2024-04", false},
+		{"FY2024-Q4", time.April, "2025-01", false},
+		{"FY2025-Q1", time.October, "2025-10", false},
+		{"2024-Q1", time.April, "", true},
+		{"FY2024-Q5", time.April, "", true},
+		{"FY2024-Q1", time.Month(13), "", true},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`ParseFiscalQuarter("%s", %d)`, tt.value, tt.start)
+
+		t.Run(testcase, func(t *testing.T) {
+			q, err := ParseFiscalQuarter(tt.value, tt.start)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidFiscalQuarter)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, q.FirstMonth().String())
+				assert.Equal(t, tt.value, q.String())
+			}
+		})
+	}
+}
+
+func TestFiscalQuarterMarshalling(t *testing.T) {
+	q := NewFiscalQuarter(2024, 4, time.October)
+
+	text, err := q.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "FY2024-Q4@10", string(text))
+
+	json, err := q.MarshalJSON()
+	assert.NoError(t, err)
+	assert.Equal(t, `"FY2024-Q4@10"`, string(json))
+
+	var fromText, fromJSON FiscalQuarter
+	assert.NoError(t, fromText.UnmarshalText(text))
+	assert.NoError(t, fromJSON.UnmarshalJSON(json))
+	assert.True(t, q.Equal(fromText), "UnmarshalText")
+	assert.True(t, q.Equal(fromJSON), "UnmarshalJSON")
+
+	for _, invalid := range []string{"FY2024-Q4", "FY2024-Q4@13", "FY2024-Q4@4", "invalid"} {
+		assert.ErrorIs(t, fromText.UnmarshalText([]byte(invalid)), ErrInvalidFiscalQuarter, invalid)
+	}
+
+	tests := []struct {
+		quarter FiscalQuarter
+		want    string
+	}{
+		{FiscalQuarter{}, "FY0001-Q1@01"},
+		{NewFiscalQuarter(2024, 1, time.Month(0)), "FY2024-Q1@12"},
+		{NewFiscalQuarter(2024, 1, time.Month(13)), "FY2024-Q1@01"},
+		{FiscalQuarterFromMonth(MustParseMonth("2024-06"), time.Month(-8)), "FY2024-Q1@04"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`FiscalQuarter{"%s"}.MarshalText()`, tt.want)
+
+		t.Run(testcase, func(t *testing.T) {
+			text, err := tt.quarter.MarshalText()
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, string(text))
+
+			var q FiscalQuarter
+			assert.NoError(t, q.UnmarshalText(text))
+			assert.True(t, tt.quarter.Equal(q), "got %s", q)
+		})
+	}
+}
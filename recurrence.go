@@ -0,0 +1,604 @@
+package date
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidRecurrenceRule = fmt.Errorf("invalid recurrence rule")
+)
+
+// Frequency is the FREQ rule part of a recurrence rule.
+type Frequency int
+
+const (
+	FrequencyDaily Frequency = iota + 1
+	FrequencyWeekly
+	FrequencyMonthly
+	FrequencyYearly
+)
+
+var frequencyNames = map[Frequency]string{
+	FrequencyDaily:   "DAILY",
+	FrequencyWeekly:  "WEEKLY",
+	FrequencyMonthly: "MONTHLY",
+	FrequencyYearly:  "YEARLY",
+}
+
+var weekdayNames = map[time.Weekday]string{
+	time.Sunday:    "SU",
+	time.Monday:    "MO",
+	time.Tuesday:   "TU",
+	time.Wednesday: "WE",
+	time.Thursday:  "TH",
+	time.Friday:    "FR",
+	time.Saturday:  "SA",
+}
+
+// String returns the name of the Frequency as used in RRULE, such as "MONTHLY".
+func (f Frequency) String() string {
+	return frequencyNames[f]
+}
+
+// weekdayNum is an element of the BYDAY rule part, such as "-1FR".
+// An ordinal of 0 means every such weekday.
+type weekdayNum struct {
+	ordinal int
+	weekday time.Weekday
+}
+
+// String returns the string representation of the weekdayNum as used in RRULE.
+func (w weekdayNum) String() string {
+	if w.ordinal == 0 {
+		return weekdayNames[w.weekday]
+	}
+
+	return strconv.Itoa(w.ordinal) + weekdayNames[w.weekday]
+}
+
+// Recurrence is an immutable set of recurring dates defined by an RFC 5545 recurrence rule (RRULE),
+// together with additional dates (RDATE) and excluded dates (EXDATE).
+// Only the date-related rule parts are supported: FREQ of DAILY, WEEKLY, MONTHLY or YEARLY,
+// INTERVAL, COUNT, UNTIL, BYDAY, BYMONTHDAY, BYMONTH, BYSETPOS and WKST.
+type Recurrence struct {
+	start      Date
+	freq       Frequency
+	interval   int
+	count      int
+	until      Date
+	byMonth    []time.Month
+	byMonthDay []int
+	byDay      []weekdayNum
+	bySetPos   []int
+	weekStart  time.Weekday
+	exDates    Dates
+	rDates     Dates
+}
+
+// Factory functions
+// --------------------------------------------------
+
+// ParseRecurrence parses an RRULE value, such as "FREQ=MONTHLY;BYDAY=-1FR", starting on the specified date.
+// A leading "RRULE:" is allowed.
+// Dates which would not exist, such as February 30, are skipped rather than clamped, as RFC 5545 requires.
+func ParseRecurrence(start Date, rule string) (Recurrence, error) {
+	r := Recurrence{
+		start:     start,
+		interval:  1,
+		weekStart: time.Monday,
+	}
+
+	rule = strings.TrimPrefix(strings.TrimSpace(rule), "RRULE:")
+
+	for _, part := range strings.Split(rule, ";") {
+		key, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return Recurrence{}, fmt.Errorf("ParseRecurrence: rule part %q has no value: %w", part, ErrInvalidRecurrenceRule)
+		}
+
+		if err := r.setRulePart(strings.ToUpper(key), strings.ToUpper(value)); err != nil {
+			return Recurrence{}, fmt.Errorf("ParseRecurrence: %w", err)
+		}
+	}
+
+	if err := r.validate(); err != nil {
+		return Recurrence{}, fmt.Errorf("ParseRecurrence: %w", err)
+	}
+
+	return r, nil
+}
+
+// MustParseRecurrence parses an RRULE value starting on the specified date.
+// It panics if the parsing fails.
+func MustParseRecurrence(start Date, rule string) Recurrence {
+	r, err := ParseRecurrence(start, rule)
+	if err != nil {
+		panic(err)
+	}
+
+	return r
+}
+
+// ParseRecurrenceSet parses the DTSTART, RRULE, RDATE and EXDATE content lines of iCalendar data.
+// Date-time values are truncated to their dates.
+func ParseRecurrenceSet(text string) (Recurrence, error) {
+	lines, err := unfoldICalendarLines(strings.NewReader(text))
+	if err != nil {
+		return Recurrence{}, fmt.Errorf("ParseRecurrenceSet: %w", err)
+	}
+
+	var start Date
+	var rule string
+	var exDates, rDates Dates
+
+	for _, line := range lines {
+		prop, err := parseICalendarProperty(line)
+		if err != nil {
+			return Recurrence{}, fmt.Errorf("ParseRecurrenceSet: %w", err)
+		}
+
+		switch prop.name {
+		case "DTSTART":
+			if start, err = parseRecurrenceDate(prop.value); err != nil {
+				return Recurrence{}, fmt.Errorf("ParseRecurrenceSet: invalid DTSTART: %w", err)
+			}
+
+		case "RRULE":
+			rule = prop.value
+
+		case "EXDATE", "RDATE":
+			for _, value := range strings.Split(prop.value, ",") {
+				d, err := parseRecurrenceDate(value)
+				if err != nil {
+					return Recurrence{}, fmt.Errorf("ParseRecurrenceSet: invalid %s: %w", prop.name, err)
+				}
+
+				if prop.name == "EXDATE" {
+					exDates = append(exDates, d)
+				} else {
+					rDates = append(rDates, d)
+				}
+			}
+		}
+	}
+
+	if start.IsZero() || rule == "" {
+		return Recurrence{}, fmt.Errorf("ParseRecurrenceSet: DTSTART and RRULE are required: %w", ErrInvalidRecurrenceRule)
+	}
+
+	r, err := ParseRecurrence(start, rule)
+	if err != nil {
+		return Recurrence{}, fmt.Errorf("ParseRecurrenceSet: %w", err)
+	}
+
+	return r.WithExDates(exDates...).WithRDates(rDates...), nil
+}
+
+// Modifier methods
+// --------------------------------------------------
+
+// WithExDates returns a copy of the Recurrence instance which excludes the specified dates (EXDATE).
+func (r Recurrence) WithExDates(dates ...Date) Recurrence {
+	r.exDates = append(r.exDates.clone(), dates...)
+
+	return r
+}
+
+// WithRDates returns a copy of the Recurrence instance which additionally includes the specified dates (RDATE).
+func (r Recurrence) WithRDates(dates ...Date) Recurrence {
+	r.rDates = append(r.rDates.clone(), dates...)
+
+	return r
+}
+
+// Conversion methods
+// --------------------------------------------------
+
+// Start returns the first date (DTSTART) of the Recurrence instance.
+func (r Recurrence) Start() Date {
+	return r.start
+}
+
+// Frequency returns the frequency (FREQ) of the Recurrence instance.
+func (r Recurrence) Frequency() Frequency {
+	return r.freq
+}
+
+// DatesIn returns the dates of the Recurrence instance within the specified DateRange in ascending order.
+// COUNT is always counted from the start date, regardless of the DateRange.
+// A Recurrence without a frequency or a positive interval, such as the zero value, generates only its RDATEs.
+func (r Recurrence) DatesIn(period DateRange) Dates {
+	excluded := make(map[civilDate]bool, len(r.exDates))
+	for _, d := range r.exDates {
+		excluded[civilDateOf(d)] = true
+	}
+
+	found := make(map[civilDate]bool)
+	ds := make(Dates, 0)
+	add := func(d Date) {
+		key := civilDateOf(d)
+		if period.Contains(d) && !excluded[key] && !found[key] {
+			found[key] = true
+			ds = append(ds, d)
+		}
+	}
+
+	n := 0
+
+generation:
+	for i := 0; r.freq != 0 && r.interval > 0; i++ {
+		periodStart := r.periodStart(i)
+		if periodStart.After(period.end) || !r.until.IsZero() && periodStart.After(r.until) {
+			break
+		}
+
+		for _, d := range r.expand(periodStart) {
+			if d.Before(r.start) {
+				continue
+			}
+
+			if !r.until.IsZero() && d.After(r.until) {
+				break generation
+			}
+
+			n++
+			if r.count > 0 && n > r.count {
+				break generation
+			}
+
+			add(d)
+		}
+	}
+
+	for _, d := range r.rDates {
+		add(d)
+	}
+
+	return ds.SortMutable()
+}
+
+// String returns the RRULE value of the Recurrence instance, such as "FREQ=MONTHLY;BYDAY=-1FR".
+func (r Recurrence) String() string {
+	parts := []string{"FREQ=" + r.freq.String()}
+
+	if r.interval != 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.interval))
+	}
+	if r.count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.count))
+	}
+	if !r.until.IsZero() {
+		parts = append(parts, "UNTIL="+r.until.Format(icalendarDateLayout))
+	}
+	if len(r.byMonth) > 0 {
+		parts = append(parts, "BYMONTH="+joinRuleValues(r.byMonth, func(m time.Month) string { return strconv.Itoa(int(m)) }))
+	}
+	if len(r.byMonthDay) > 0 {
+		parts = append(parts, "BYMONTHDAY="+joinRuleValues(r.byMonthDay, strconv.Itoa))
+	}
+	if len(r.byDay) > 0 {
+		parts = append(parts, "BYDAY="+joinRuleValues(r.byDay, weekdayNum.String))
+	}
+	if len(r.bySetPos) > 0 {
+		parts = append(parts, "BYSETPOS="+joinRuleValues(r.bySetPos, strconv.Itoa))
+	}
+	if r.weekStart != time.Monday {
+		parts = append(parts, "WKST="+weekdayNames[r.weekStart])
+	}
+
+	return strings.Join(parts, ";")
+}
+
+// periodStart returns the first date of the nth period of the Recurrence instance.
+func (r Recurrence) periodStart(n int) Date {
+	switch r.freq {
+	case FrequencyWeekly:
+		offset := (int(r.start.Weekday()) - int(r.weekStart) + 7) % 7
+
+		return r.start.SubDays(offset).AddWeeks(n * r.interval)
+
+	case FrequencyMonthly:
+		return r.start.ToMonth().AddMonths(n * r.interval).FirstDate()
+
+	case FrequencyYearly:
+		return NewDate(r.start.Year()+n*r.interval, time.January, 1)
+	}
+
+	return r.start.AddDays(n * r.interval)
+}
+
+// expand returns the dates of the period starting on the specified date which match the rule parts.
+func (r Recurrence) expand(periodStart Date) Dates {
+	var end Date
+
+	switch r.freq {
+	case FrequencyDaily:
+		end = periodStart
+	case FrequencyWeekly:
+		end = periodStart.AddDays(6)
+	case FrequencyMonthly:
+		end = periodStart.EndOfMonth()
+	case FrequencyYearly:
+		end = periodStart.EndOfYear()
+	}
+
+	ds := make(Dates, 0)
+	for d := periodStart; d.BeforeOrEqual(end); d = d.AddDay() {
+		if r.matches(d) {
+			ds = append(ds, d)
+		}
+	}
+
+	if len(r.bySetPos) == 0 {
+		return ds
+	}
+
+	selected := make(Dates, 0, len(r.bySetPos))
+	for _, pos := range r.bySetPos {
+		i := pos - 1
+		if pos < 0 {
+			i = len(ds) + pos
+		}
+
+		if i >= 0 && i < len(ds) && !selected.contains(ds[i]) {
+			selected = append(selected, ds[i])
+		}
+	}
+
+	return selected.SortMutable()
+}
+
+// matches checks if the specified date matches the BYxxx rule parts, or the defaults derived from the start date.
+func (r Recurrence) matches(d Date) bool {
+	if len(r.byMonth) > 0 {
+		if !containsValue(r.byMonth, d.Month()) {
+			return false
+		}
+	} else if r.freq == FrequencyYearly && len(r.byMonthDay) == 0 && len(r.byDay) == 0 && d.Month() != r.start.Month() {
+		return false
+	}
+
+	if len(r.byMonthDay) > 0 {
+		last := d.EndOfMonth().Day()
+		matched := false
+
+		for _, day := range r.byMonthDay {
+			if day == d.Day() || day < 0 && last+day+1 == d.Day() {
+				matched = true
+			}
+		}
+
+		if !matched {
+			return false
+		}
+	} else if (r.freq == FrequencyMonthly || r.freq == FrequencyYearly) && len(r.byDay) == 0 && d.Day() != r.start.Day() {
+		return false
+	}
+
+	if len(r.byDay) > 0 {
+		for _, wd := range r.byDay {
+			if wd.weekday == d.Weekday() && (wd.ordinal == 0 || r.ordinalMatches(d, wd.ordinal)) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return r.freq != FrequencyWeekly || d.Weekday() == r.start.Weekday()
+}
+
+// ordinalMatches checks if the specified date is the nth occurrence of its weekday
+// within its month or, for YEARLY rules without BYMONTH, within its year.
+// Negative ordinals count from the end.
+func (r Recurrence) ordinalMatches(d Date, ordinal int) bool {
+	day, days := d.Day(), d.EndOfMonth().Day()
+	if r.freq == FrequencyYearly && len(r.byMonth) == 0 {
+		day, days = d.YearDay(), d.EndOfYear().YearDay()
+	}
+
+	if ordinal > 0 {
+		return (day-1)/7+1 == ordinal
+	}
+
+	return (days-day)/7+1 == -ordinal
+}
+
+// setRulePart sets the value of a rule part.
+func (r *Recurrence) setRulePart(key, value string) error {
+	var err error
+
+	switch key {
+	case "FREQ":
+		r.freq = 0
+		for f, name := range frequencyNames {
+			if name == value {
+				r.freq = f
+			}
+		}
+
+		if r.freq == 0 {
+			return fmt.Errorf("unsupported FREQ %q: %w", value, ErrInvalidRecurrenceRule)
+		}
+
+	case "INTERVAL":
+		r.interval, err = strconv.Atoi(value)
+		if err != nil || r.interval <= 0 {
+			return fmt.Errorf("invalid INTERVAL %q: %w", value, ErrInvalidRecurrenceRule)
+		}
+
+	case "COUNT":
+		r.count, err = strconv.Atoi(value)
+		if err != nil || r.count <= 0 {
+			return fmt.Errorf("invalid COUNT %q: %w", value, ErrInvalidRecurrenceRule)
+		}
+
+	case "UNTIL":
+		r.until, err = parseRecurrenceDate(value)
+		if err != nil {
+			return fmt.Errorf("invalid UNTIL %q: %w", value, ErrInvalidRecurrenceRule)
+		}
+
+	case "BYMONTH":
+		months, err := parseRuleInts(value, 1, 12, false)
+		if err != nil {
+			return fmt.Errorf("invalid BYMONTH %q: %w", value, err)
+		}
+
+		for _, m := range months {
+			r.byMonth = append(r.byMonth, time.Month(m))
+		}
+
+	case "BYMONTHDAY":
+		r.byMonthDay, err = parseRuleInts(value, 1, 31, true)
+		if err != nil {
+			return fmt.Errorf("invalid BYMONTHDAY %q: %w", value, err)
+		}
+
+	case "BYSETPOS":
+		r.bySetPos, err = parseRuleInts(value, 1, 366, true)
+		if err != nil {
+			return fmt.Errorf("invalid BYSETPOS %q: %w", value, err)
+		}
+
+	case "BYDAY":
+		for _, v := range strings.Split(value, ",") {
+			wd, err := parseWeekdayNum(v)
+			if err != nil {
+				return fmt.Errorf("invalid BYDAY %q: %w", value, err)
+			}
+
+			r.byDay = append(r.byDay, wd)
+		}
+
+	case "WKST":
+		wd, err := parseWeekdayNum(value)
+		if err != nil || wd.ordinal != 0 {
+			return fmt.Errorf("invalid WKST %q: %w", value, ErrInvalidRecurrenceRule)
+		}
+
+		r.weekStart = wd.weekday
+
+	default:
+		return fmt.Errorf("unsupported rule part %q: %w", key, ErrInvalidRecurrenceRule)
+	}
+
+	return nil
+}
+
+// validate checks the combination of the rule parts.
+func (r Recurrence) validate() error {
+	if r.freq == 0 {
+		return fmt.Errorf("FREQ is required: %w", ErrInvalidRecurrenceRule)
+	}
+
+	if r.count > 0 && !r.until.IsZero() {
+		return fmt.Errorf("COUNT and UNTIL cannot be used together: %w", ErrInvalidRecurrenceRule)
+	}
+
+	if r.freq == FrequencyWeekly && len(r.byMonthDay) > 0 {
+		return fmt.Errorf("BYMONTHDAY cannot be used with FREQ=WEEKLY: %w", ErrInvalidRecurrenceRule)
+	}
+
+	if r.freq == FrequencyDaily || r.freq == FrequencyWeekly {
+		for _, wd := range r.byDay {
+			if wd.ordinal != 0 {
+				return fmt.Errorf("BYDAY with ordinals requires FREQ=MONTHLY or FREQ=YEARLY: %w", ErrInvalidRecurrenceRule)
+			}
+		}
+	}
+
+	if len(r.bySetPos) > 0 && len(r.byMonth) == 0 && len(r.byMonthDay) == 0 && len(r.byDay) == 0 {
+		return fmt.Errorf("BYSETPOS requires another BYxxx rule part: %w", ErrInvalidRecurrenceRule)
+	}
+
+	return nil
+}
+
+// parseRecurrenceDate parses a DATE or DATE-TIME value, keeping only the date.
+func parseRecurrenceDate(value string) (Date, error) {
+	if len(value) < len(icalendarDateLayout) {
+		return ZeroDate(), fmt.Errorf("date %q is too short: %w", value, ErrInvalidRecurrenceRule)
+	}
+
+	return CustomParse(icalendarDateLayout, value[:len(icalendarDateLayout)])
+}
+
+// parseWeekdayNum parses an element of BYDAY, such as "MO", "2TU" or "-1FR".
+func parseWeekdayNum(value string) (weekdayNum, error) {
+	if len(value) < 2 {
+		return weekdayNum{}, ErrInvalidRecurrenceRule
+	}
+
+	name := value[len(value)-2:]
+	for wd, n := range weekdayNames {
+		if n != name {
+			continue
+		}
+
+		if len(value) == 2 {
+			return weekdayNum{0, wd}, nil
+		}
+
+		ordinal, err := strconv.Atoi(value[:len(value)-2])
+		if err != nil || ordinal == 0 || ordinal < -53 || ordinal > 53 {
+			return weekdayNum{}, ErrInvalidRecurrenceRule
+		}
+
+		return weekdayNum{ordinal, wd}, nil
+	}
+
+	return weekdayNum{}, ErrInvalidRecurrenceRule
+}
+
+// parseRuleInts parses a comma-separated list of integers between min and max,
+// also allowing their negatives if negative is true.
+func parseRuleInts(value string, min, max int, negative bool) ([]int, error) {
+	values := strings.Split(value, ",")
+	ints := make([]int, 0, len(values))
+
+	for _, v := range values {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, ErrInvalidRecurrenceRule
+		}
+
+		abs := n
+		if negative && n < 0 {
+			abs = -n
+		}
+
+		if abs < min || abs > max {
+			return nil, ErrInvalidRecurrenceRule
+		}
+
+		ints = append(ints, n)
+	}
+
+	return ints, nil
+}
+
+// joinRuleValues joins the values of a rule part with commas.
+func joinRuleValues[T any](values []T, format func(T) string) string {
+	strs := make([]string, len(values))
+
+	for i, v := range values {
+		strs[i] = format(v)
+	}
+
+	return strings.Join(strs, ",")
+}
+
+// containsValue checks if the slice contains the value.
+func containsValue[T comparable](values []T, value T) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,258 @@
+package date
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Factory functions
+// --------------------------------------------------
+
+func TestParseRecurrence(t *testing.T) {
+	tests := []struct {
+		rule string
+		want string
+	}{
+		{"FREQ=DAILY", "FREQ=DAILY"},
+		{"RRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE", "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE"},
+		{"freq=monthly;byday=-1fr", "FREQ=MONTHLY;BYDAY=-1FR"},
+		{"FREQ=MONTHLY;BYMONTHDAY=-1;COUNT=3", "FREQ=MONTHLY;COUNT=3;BYMONTHDAY=-1"},
+		{"FREQ=YEARLY;BYMONTH=11;BYDAY=4TH;UNTIL=20301231T000000Z", "FREQ=YEARLY;UNTIL=20301231;BYMONTH=11;BYDAY=4TH"},
+		{"FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1;WKST=SU", "FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1;WKST=SU"},
+		{"", "error"},
+		{"INTERVAL=2", "error"},
+		{"FREQ=HOURLY", "error"},
+		{"FREQ=DAILY;INTERVAL=0", "error"},
+		{"FREQ=DAILY;COUNT=-1", "error"},
+		{"FREQ=DAILY;COUNT=2;UNTIL=20240101", "error"},
+		{"FREQ=DAILY;UNTIL=2024", "error"},
+		{"FREQ=WEEKLY;BYMONTHDAY=1", "error"},
+		{"FREQ=WEEKLY;BYDAY=1MO", "error"},
+		{"FREQ=MONTHLY;BYDAY=0MO", "error"},
+		{"FREQ=MONTHLY;BYDAY=XX", "error"},
+		{"FREQ=MONTHLY;BYMONTHDAY=32", "error"},
+		{"FREQ=MONTHLY;BYMONTH=13", "error"},
+		{"FREQ=MONTHLY;BYSETPOS=1", "error"},
+		{"FREQ=YEARLY;BYWEEKNO=20", "error"},
+		{"FREQ=YEARLY;WKST=1MO", "error"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf("ParseRecurrence(%q)", tt.rule)
+
+		t.Run(testcase, func(t *testing.T) {
+			r, err := ParseRecurrence(MustParse("2024-01-01"), tt.rule)
+			if tt.want == "error" {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, r.String())
+			}
+		})
+	}
+}
+
+func TestMustParseRecurrence(t *testing.T) {
+	t.Run("MustParseRecurrence()", func(t *testing.T) {
+		assert.Equal(t, "FREQ=DAILY", MustParseRecurrence(MustParse("2024-01-01"), "FREQ=DAILY").String())
+		assert.Panics(t, func() { MustParseRecurrence(MustParse("2024-01-01"), "FREQ=SECONDLY") })
+	})
+}
+
+func TestParseRecurrenceSet(t *testing.T) {
+	tests := []struct {
+		text string
+		want []string
+	}{
+		{
+			"DTSTART;VALUE=DATE:20240101\nRRULE:FREQ=WEEKLY;COUNT=4\nEXDATE;VALUE=DATE:20240108\nRDATE;VALUE=DATE:20240110,20240111",
+			[]string{"2024-01-01", "2024-01-10", "2024-01-11", "2024-01-15", "2024-01-22"},
+		},
+		{
+			"DTSTART:20240101T090000Z\r\nRRULE:FREQ=DAILY;COUNT=2",
+			[]string{"2024-01-01", "2024-01-02"},
+		},
+		{"RRULE:FREQ=DAILY", nil},
+		{"DTSTART;VALUE=DATE:20240101", nil},
+		{"DTSTART;VALUE=DATE:2024", nil},
+		{"DTSTART;VALUE=DATE:20240101\nRRULE:FREQ=DAILY\nEXDATE:x", nil},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf("ParseRecurrenceSet(%q)", tt.text)
+
+		t.Run(testcase, func(t *testing.T) {
+			r, err := ParseRecurrenceSet(tt.text)
+			if tt.want == nil {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, r.DatesIn(MustParseDateRange("2024-01-01", "2024-12-31")).Strings())
+			}
+		})
+	}
+}
+
+// Modifier methods
+// --------------------------------------------------
+
+func TestRecurrenceWithExDates(t *testing.T) {
+	t.Run("Recurrence.WithExDates()", func(t *testing.T) {
+		r := MustParseRecurrence(MustParse("2024-01-01"), "FREQ=DAILY;COUNT=3")
+		excluded := r.WithExDates(MustParse("2024-01-02"))
+		period := MustParseDateRange("2024-01-01", "2024-01-31")
+
+		assert.Equal(t, []string{"2024-01-01", "2024-01-02", "2024-01-03"}, r.DatesIn(period).Strings())
+		assert.Equal(t, []string{"2024-01-01", "2024-01-03"}, excluded.DatesIn(period).Strings())
+	})
+}
+
+func TestRecurrenceWithRDates(t *testing.T) {
+	t.Run("Recurrence.WithRDates()", func(t *testing.T) {
+		r := MustParseRecurrence(MustParse("2024-01-01"), "FREQ=DAILY;COUNT=1").
+			WithRDates(MustParse("2024-01-05"), MustParse("2024-01-01"), MustParse("2024-03-01"))
+
+		assert.Equal(t, []string{"2024-01-01", "2024-01-05"}, r.DatesIn(MustParseDateRange("2024-01-01", "2024-01-31")).Strings())
+	})
+}
+
+// Conversion methods
+// --------------------------------------------------
+
+func TestRecurrenceStart(t *testing.T) {
+	t.Run("Recurrence.Start()", func(t *testing.T) {
+		assert.Equal(t, "2024-01-01", MustParseRecurrence(MustParse("2024-01-01"), "FREQ=DAILY").Start().String())
+	})
+}
+
+func TestRecurrenceFrequency(t *testing.T) {
+	t.Run("Recurrence.Frequency()", func(t *testing.T) {
+		r := MustParseRecurrence(MustParse("2024-01-01"), "FREQ=YEARLY")
+
+		assert.Equal(t, FrequencyYearly, r.Frequency())
+		assert.Equal(t, "YEARLY", r.Frequency().String())
+	})
+}
+
+func TestRecurrenceDatesIn(t *testing.T) {
+	tests := []struct {
+		start  string
+		rule   string
+		period DateRange
+		want   []string
+	}{
+		{
+			"2024-01-30", "FREQ=DAILY;INTERVAL=2",
+			MustParseDateRange("2024-02-01", "2024-02-06"),
+			[]string{"2024-02-01", "2024-02-03", "2024-02-05"},
+		},
+		{
+			"2024-01-01", "FREQ=DAILY;BYDAY=SA,SU;BYMONTH=2",
+			MustParseDateRange("2024-01-01", "2024-02-12"),
+			[]string{"2024-02-03", "2024-02-04", "2024-02-10", "2024-02-11"},
+		},
+		{
+			"2024-01-03", "FREQ=WEEKLY;COUNT=3",
+			MustParseDateRange("2024-01-01", "2024-12-31"),
+			[]string{"2024-01-03", "2024-01-10", "2024-01-17"},
+		},
+		{
+			"2024-01-03", "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,FR;UNTIL=20240201",
+			MustParseDateRange("2024-01-01", "2024-12-31"),
+			[]string{"2024-01-05", "2024-01-15", "2024-01-19", "2024-01-29"},
+		},
+		{
+			"2024-01-31", "FREQ=MONTHLY;COUNT=4",
+			MustParseDateRange("2024-01-01", "2024-12-31"),
+			[]string{"2024-01-31", "2024-03-31", "2024-05-31", "2024-07-31"},
+		},
+		{
+			"2024-01-31", "FREQ=MONTHLY;BYMONTHDAY=-1;COUNT=4",
+			MustParseDateRange("2024-01-01", "2024-12-31"),
+			[]string{"2024-01-31", "2024-02-29", "2024-03-31", "2024-04-30"},
+		},
+		{
+			"2024-01-01", "FREQ=MONTHLY;BYDAY=-1FR;COUNT=3",
+			MustParseDateRange("2024-01-01", "2024-12-31"),
+			[]string{"2024-01-26", "2024-02-23", "2024-03-29"},
+		},
+		{
+			"2024-01-01", "FREQ=MONTHLY;BYDAY=2TU",
+			MustParseDateRange("2024-04-01", "2024-05-31"),
+			[]string{"2024-04-09", "2024-05-14"},
+		},
+		{
+			"2024-01-01", "FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1",
+			MustParseDateRange("2024-03-01", "2024-06-30"),
+			[]string{"2024-03-29", "2024-04-30", "2024-05-31", "2024-06-28"},
+		},
+		{
+			"2024-01-01", "FREQ=MONTHLY;BYDAY=FR;BYMONTHDAY=13",
+			MustParseDateRange("2024-01-01", "2024-12-31"),
+			[]string{"2024-09-13", "2024-12-13"},
+		},
+		{
+			"2024-01-01", "FREQ=MONTHLY;INTERVAL=3;BYMONTHDAY=1,15",
+			MustParseDateRange("2024-01-01", "2024-07-31"),
+			[]string{"2024-01-01", "2024-01-15", "2024-04-01", "2024-04-15", "2024-07-01", "2024-07-15"},
+		},
+		{
+			"2020-02-29", "FREQ=YEARLY",
+			MustParseDateRange("2020-01-01", "2028-12-31"),
+			[]string{"2020-02-29", "2024-02-29", "2028-02-29"},
+		},
+		{
+			"2024-01-01", "FREQ=YEARLY;BYMONTH=11;BYDAY=4TH",
+			MustParseDateRange("2024-01-01", "2026-12-31"),
+			[]string{"2024-11-28", "2025-11-27", "2026-11-26"},
+		},
+		{
+			"2024-01-01", "FREQ=YEARLY;BYDAY=1MO",
+			MustParseDateRange("2024-01-01", "2025-12-31"),
+			[]string{"2024-01-01", "2025-01-06"},
+		},
+		{
+			"2024-01-01", "FREQ=YEARLY;BYDAY=-1SU",
+			MustParseDateRange("2024-01-01", "2025-12-31"),
+			[]string{"2024-12-29", "2025-12-28"},
+		},
+		{
+			"2024-01-01", "FREQ=YEARLY;BYMONTH=3,6;BYMONTHDAY=-1;COUNT=3",
+			MustParseDateRange("2024-01-01", "2030-12-31"),
+			[]string{"2024-03-31", "2024-06-30", "2025-03-31"},
+		},
+		{
+			"2024-01-01", "FREQ=DAILY;COUNT=10",
+			MustParseDateRange("2024-01-08", "2024-01-31"),
+			[]string{"2024-01-08", "2024-01-09", "2024-01-10"},
+		},
+		{
+			"2024-01-01", "FREQ=MONTHLY;BYMONTHDAY=30;BYMONTH=2",
+			MustParseDateRange("2024-01-01", "2030-12-31"),
+			[]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Recurrence{"%s",%q}.DatesIn(DateRange{"%s"})`, tt.start, tt.rule, tt.period)
+
+		t.Run(testcase, func(t *testing.T) {
+			r := MustParseRecurrence(MustParse(tt.start), tt.rule)
+
+			assert.Equal(t, tt.want, r.DatesIn(tt.period).Strings())
+		})
+	}
+}
+
+func TestZeroRecurrenceDatesIn(t *testing.T) {
+	period := MustParseDateRange("2024-01-01", "2024-12-31")
+
+	assert.Equal(t, []string{}, Recurrence{}.DatesIn(period).Strings())
+	assert.Equal(
+		t,
+		[]string{"2024-06-05"},
+		Recurrence{}.WithRDates(MustParse("2024-06-05")).DatesIn(period).Strings(),
+	)
+}
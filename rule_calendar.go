@@ -0,0 +1,319 @@
+package date
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// Observance describes how a holiday falling on a weekend is observed on a weekday.
+type Observance int
+
+const (
+	// ObserveActualDay observes the holiday only on its actual day.
+	ObserveActualDay Observance = iota
+	// ObserveNearestWeekday observes a Saturday holiday on the preceding Friday
+	// and a Sunday holiday on the following Monday, as US federal holidays do.
+	ObserveNearestWeekday
+	// ObserveNextWeekday observes a weekend holiday on the next weekday that is not already a holiday,
+	// as UK bank holidays do.
+	ObserveNextWeekday
+	// ObserveSundayToMonday observes a Sunday holiday on the following Monday and leaves Saturday holidays as they are.
+	ObserveSundayToMonday
+)
+
+// HolidayRule describes a holiday which recurs every year.
+// Rules are immutable, so the modifier methods return a new HolidayRule instance.
+type HolidayRule struct {
+	name       string
+	date       func(year int) (Date, bool)
+	observance Observance
+	from       int
+	to         int
+}
+
+// Factory functions
+// --------------------------------------------------
+
+// FixedDateHoliday creates a HolidayRule for a holiday on the same date every year, such as January 1.
+func FixedDateHoliday(name string, month time.Month, day int) HolidayRule {
+	return HolidayRule{
+		name: name,
+		date: func(year int) (Date, bool) {
+			d := NewDate(year, month, day)
+
+			return d, d.Month() == month
+		},
+	}
+}
+
+// NthWeekdayHoliday creates a HolidayRule for a holiday on the nth weekday of the month, such as the 3rd Monday of January.
+// Years in which the month has no nth weekday have no holiday.
+func NthWeekdayHoliday(name string, month time.Month, weekday time.Weekday, n int) HolidayRule {
+	return HolidayRule{
+		name: name,
+		date: func(year int) (Date, bool) {
+			first := NewDate(year, month, 1)
+			offset := (int(weekday) - int(first.Weekday()) + 7) % 7
+			d := first.AddDays(offset + (n-1)*7)
+
+			return d, n > 0 && d.Month() == month
+		},
+	}
+}
+
+// LastWeekdayHoliday creates a HolidayRule for a holiday on the last weekday of the month, such as the last Monday of May.
+func LastWeekdayHoliday(name string, month time.Month, weekday time.Weekday) HolidayRule {
+	return HolidayRule{
+		name: name,
+		date: func(year int) (Date, bool) {
+			last := NewMonth(year, month).LastDate()
+			offset := (int(last.Weekday()) - int(weekday) + 7) % 7
+
+			return last.SubDays(offset), true
+		},
+	}
+}
+
+// EasterHoliday creates a HolidayRule for a holiday relative to Easter Sunday in the Gregorian calendar.
+// For example, an offset of -2 is Good Friday and an offset of 1 is Easter Monday.
+func EasterHoliday(name string, offset int) HolidayRule {
+	return HolidayRule{
+		name: name,
+		date: func(year int) (Date, bool) {
+			return easterSunday(year).AddDays(offset), true
+		},
+	}
+}
+
+// Modifier methods
+// --------------------------------------------------
+
+// Observed returns a copy of the HolidayRule instance observed with the specified Observance.
+func (r HolidayRule) Observed(observance Observance) HolidayRule {
+	r.observance = observance
+
+	return r
+}
+
+// ValidFrom returns a copy of the HolidayRule instance which applies from the specified year.
+func (r HolidayRule) ValidFrom(year int) HolidayRule {
+	r.from = year
+
+	return r
+}
+
+// ValidTo returns a copy of the HolidayRule instance which applies up to and including the specified year.
+func (r HolidayRule) ValidTo(year int) HolidayRule {
+	r.to = year
+
+	return r
+}
+
+// Conversion methods
+// --------------------------------------------------
+
+// Name returns the name of the HolidayRule instance.
+func (r HolidayRule) Name() string {
+	return r.name
+}
+
+// DateIn returns the actual date of the holiday in the specified year.
+// The second return value reports whether the rule applies in that year.
+func (r HolidayRule) DateIn(year int) (Date, bool) {
+	if r.from != 0 && year < r.from || r.to != 0 && year > r.to {
+		return ZeroDate(), false
+	}
+
+	return r.date(year)
+}
+
+// RuleCalendar is a NamedCalendar built from HolidayRule instances.
+type RuleCalendar struct {
+	rules []HolidayRule
+	mu    sync.Mutex
+	years map[int]map[monthDay]string
+}
+
+// NewRuleCalendar creates a new RuleCalendar instance with the specified rules.
+func NewRuleCalendar(rules ...HolidayRule) *RuleCalendar {
+	return &RuleCalendar{
+		rules: append([]HolidayRule{}, rules...),
+	}
+}
+
+// Determination methods
+// --------------------------------------------------
+
+// IsHoliday checks if the specified date is a holiday, either on its actual day or on its observed day.
+func (c *RuleCalendar) IsHoliday(date Date) bool {
+	_, ok := c.HolidayName(date)
+
+	return ok
+}
+
+// Conversion methods
+// --------------------------------------------------
+
+// HolidayName returns the name of the holiday on the specified date.
+// The second return value reports whether the date is a holiday.
+func (c *RuleCalendar) HolidayName(date Date) (string, bool) {
+	name, ok := c.holidays(date.Year())[monthDay{date.Month(), date.Day()}]
+
+	return name, ok
+}
+
+// HolidaysInYear returns the holidays in the specified year in ascending order,
+// including both the actual and the observed days.
+func (c *RuleCalendar) HolidaysInYear(year int) Dates {
+	holidays := c.holidays(year)
+	ds := make(Dates, 0, len(holidays))
+
+	for md := range holidays {
+		ds = append(ds, NewDate(year, md.month, md.day))
+	}
+
+	return ds.SortMutable()
+}
+
+// HolidaysInMonth returns the holidays in the specified Month in ascending order.
+func (c *RuleCalendar) HolidaysInMonth(month Month) Dates {
+	ds := make(Dates, 0)
+
+	for _, d := range c.HolidaysInYear(month.Year()) {
+		if d.Month() == month.Month() {
+			ds = append(ds, d)
+		}
+	}
+
+	return ds
+}
+
+// holidays returns the holidays in the specified year, computing and caching them on first use.
+func (c *RuleCalendar) holidays(year int) map[monthDay]string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if holidays, ok := c.years[year]; ok {
+		return holidays
+	}
+
+	if c.years == nil {
+		c.years = make(map[int]map[monthDay]string)
+	}
+
+	holidays := make(map[monthDay]string)
+	// Observed days can cross the year boundary, so the neighbouring years are computed as well.
+	for key, name := range c.holidaysAround(year) {
+		if key.year == year {
+			holidays[key.monthDay] = name
+		}
+	}
+	c.years[year] = holidays
+
+	return holidays
+}
+
+// holidaysAround computes the actual and observed holidays of the specified year and its neighbouring years.
+func (c *RuleCalendar) holidaysAround(year int) map[civilDate]string {
+	type occurrence struct {
+		date Date
+		rule HolidayRule
+	}
+
+	occurrences := make([]occurrence, 0, len(c.rules)*3)
+	for y := year - 1; y <= year+1; y++ {
+		for _, rule := range c.rules {
+			if d, ok := rule.DateIn(y); ok {
+				occurrences = append(occurrences, occurrence{d, rule})
+			}
+		}
+	}
+
+	sort.SliceStable(occurrences, func(i, j int) bool {
+		return occurrences[i].date.Before(occurrences[j].date)
+	})
+
+	holidays := make(map[civilDate]string, len(occurrences))
+	for _, o := range occurrences {
+		if _, ok := holidays[civilDateOf(o.date)]; !ok {
+			holidays[civilDateOf(o.date)] = o.rule.name
+		}
+	}
+
+	for _, o := range occurrences {
+		observed := o.rule.observance.observe(o.date, func(d Date) bool {
+			_, ok := holidays[civilDateOf(d)]
+
+			return ok
+		})
+
+		if _, ok := holidays[civilDateOf(observed)]; !ok {
+			holidays[civilDateOf(observed)] = o.rule.name
+		}
+	}
+
+	return holidays
+}
+
+// civilDate is a comparable year, month and day, used as a map key instead of Date.
+type civilDate struct {
+	year int
+	monthDay
+}
+
+// civilDateOf returns the civilDate of the specified Date.
+func civilDateOf(d Date) civilDate {
+	return civilDate{d.Year(), monthDay{d.Month(), d.Day()}}
+}
+
+// observe returns the day on which a holiday on the specified date is observed.
+func (o Observance) observe(date Date, isHoliday func(Date) bool) Date {
+	switch o {
+	case ObserveNearestWeekday:
+		if date.IsSaturday() {
+			return date.SubDay()
+		}
+		if date.IsSunday() {
+			return date.AddDay()
+		}
+
+	case ObserveNextWeekday:
+		if date.IsWeekend() {
+			d := date.AddDay()
+			for d.IsWeekend() || isHoliday(d) {
+				d = d.AddDay()
+			}
+
+			return d
+		}
+
+	case ObserveSundayToMonday:
+		if date.IsSunday() {
+			return date.AddDay()
+		}
+	}
+
+	return date
+}
+
+// easterSunday returns the date of Easter Sunday in the Gregorian calendar
+// using the anonymous Gregorian algorithm.
+func easterSunday(year int) Date {
+	a := year % 19
+	b := year / 100
+	c := year % 100
+	d := b / 4
+	e := b % 4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i := c / 4
+	k := c % 4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+
+	return NewDate(year, time.Month(month), day)
+}
@@ -0,0 +1,291 @@
+package date
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func usFederalHolidays() *RuleCalendar {
+	return NewRuleCalendar(
+		FixedDateHoliday("New Year's Day", time.January, 1).Observed(ObserveNearestWeekday),
+		NthWeekdayHoliday("Martin Luther King Jr. Day", time.January, time.Monday, 3),
+		LastWeekdayHoliday("Memorial Day", time.May, time.Monday),
+		FixedDateHoliday("Juneteenth", time.June, 19).Observed(ObserveNearestWeekday).ValidFrom(2021),
+		FixedDateHoliday("Independence Day", time.July, 4).Observed(ObserveNearestWeekday),
+		NthWeekdayHoliday("Thanksgiving Day", time.November, time.Thursday, 4),
+		FixedDateHoliday("Christmas Day", time.December, 25).Observed(ObserveNearestWeekday),
+	)
+}
+
+func ukBankHolidays() *RuleCalendar {
+	return NewRuleCalendar(
+		EasterHoliday("Good Friday", -2),
+		EasterHoliday("Easter Monday", 1),
+		FixedDateHoliday("Christmas Day", time.December, 25).Observed(ObserveNextWeekday),
+		FixedDateHoliday("Boxing Day", time.December, 26).Observed(ObserveNextWeekday),
+	)
+}
+
+// Factory functions
+// --------------------------------------------------
+
+func TestFixedDateHoliday(t *testing.T) {
+	tests := []struct {
+		month time.Month
+		day   int
+		year  int
+		want  string
+	}{
+		{time.January, 1, 2024, "2024-01-01"},
+		{time.February, 29, 2024, "2024-02-29"},
+		{time.February, 29, 2023, ""},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf("FixedDateHoliday(%d, %d).DateIn(%d)", int(tt.month), tt.day, tt.year)
+
+		t.Run(testcase, func(t *testing.T) {
+			d, ok := FixedDateHoliday("", tt.month, tt.day).DateIn(tt.year)
+
+			assert.Equal(t, tt.want != "", ok)
+			if ok {
+				assert.Equal(t, tt.want, d.String())
+			}
+		})
+	}
+}
+
+func TestNthWeekdayHoliday(t *testing.T) {
+	tests := []struct {
+		month   time.Month
+		weekday time.Weekday
+		n       int
+		year    int
+		want    string
+	}{
+		{time.January, time.Monday, 1, 2024, "2024-01-01"},
+		{time.January, time.Monday, 3, 2024, "2024-01-15"},
+		{time.November, time.Thursday, 4, 2024, "2024-11-28"},
+		{time.January, time.Monday, 5, 2024, "2024-01-29"},
+		{time.February, time.Monday, 5, 2024, ""},
+		{time.January, time.Monday, 0, 2024, ""},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf("NthWeekdayHoliday(%d, %s, %d).DateIn(%d)", int(tt.month), tt.weekday, tt.n, tt.year)
+
+		t.Run(testcase, func(t *testing.T) {
+			d, ok := NthWeekdayHoliday("", tt.month, tt.weekday, tt.n).DateIn(tt.year)
+
+			assert.Equal(t, tt.want != "", ok)
+			if ok {
+				assert.Equal(t, tt.want, d.String())
+			}
+		})
+	}
+}
+
+func TestLastWeekdayHoliday(t *testing.T) {
+	tests := []struct {
+		month   time.Month
+		weekday time.Weekday
+		year    int
+		want    string
+	}{
+		{time.May, time.Monday, 2024, "2024-05-27"},
+		{time.May, time.Friday, 2024, "2024-05-31"},
+		{time.August, time.Monday, 2024, "2024-08-26"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf("LastWeekdayHoliday(%d, %s).DateIn(%d)", int(tt.month), tt.weekday, tt.year)
+
+		t.Run(testcase, func(t *testing.T) {
+			d, ok := LastWeekdayHoliday("", tt.month, tt.weekday).DateIn(tt.year)
+
+			assert.True(t, ok)
+			assert.Equal(t, tt.want, d.String())
+		})
+	}
+}
+
+func TestEasterHoliday(t *testing.T) {
+	tests := []struct {
+		offset int
+		year   int
+		want   string
+	}{
+		{0, 2024, "2024-03-31"},
+		{0, 2025, "2025-04-20"},
+		{0, 2000, "2000-04-23"},
+		{-2, 2024, "2024-03-29"},
+		{1, 2024, "2024-04-01"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf("EasterHoliday(%d).DateIn(%d)", tt.offset, tt.year)
+
+		t.Run(testcase, func(t *testing.T) {
+			d, ok := EasterHoliday("", tt.offset).DateIn(tt.year)
+
+			assert.True(t, ok)
+			assert.Equal(t, tt.want, d.String())
+		})
+	}
+}
+
+// Modifier methods
+// --------------------------------------------------
+
+func TestHolidayRuleValidFromAndValidTo(t *testing.T) {
+	rule := FixedDateHoliday("", time.June, 19).ValidFrom(2021).ValidTo(2023)
+
+	tests := []struct {
+		year int
+		want bool
+	}{
+		{2020, false},
+		{2021, true},
+		{2023, true},
+		{2024, false},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf("HolidayRule.ValidFrom(2021).ValidTo(2023).DateIn(%d)", tt.year)
+
+		t.Run(testcase, func(t *testing.T) {
+			_, ok := rule.DateIn(tt.year)
+
+			assert.Equal(t, tt.want, ok)
+		})
+	}
+}
+
+func TestHolidayRuleName(t *testing.T) {
+	t.Run("HolidayRule.Name()", func(t *testing.T) {
+		assert.Equal(t, "Boxing Day", FixedDateHoliday("Boxing Day", time.December, 26).Name())
+	})
+}
+
+// Determination methods
+// --------------------------------------------------
+
+func TestRuleCalendarIsHoliday(t *testing.T) {
+	tests := []struct {
+		cal  *RuleCalendar
+		date string
+		want bool
+	}{
+		{usFederalHolidays(), "2024-01-15", true},
+		{usFederalHolidays(), "2024-01-16", false},
+		{usFederalHolidays(), "2020-06-19", false},
+		{usFederalHolidays(), "2021-06-18", true},
+		{usFederalHolidays(), "2021-12-31", true},
+		{usFederalHolidays(), "2021-07-05", true},
+		{ukBankHolidays(), "2021-12-27", true},
+		{ukBankHolidays(), "2021-12-28", true},
+		{ukBankHolidays(), "2021-12-29", false},
+		{ukBankHolidays(), "2022-12-26", true},
+		{ukBankHolidays(), "2022-12-27", true},
+		{ukBankHolidays(), "2022-12-28", false},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`RuleCalendar.IsHoliday(Date{"%s"})`, tt.date)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.cal.IsHoliday(MustParse(tt.date)))
+		})
+	}
+}
+
+func TestObserveSundayToMonday(t *testing.T) {
+	cal := NewRuleCalendar(
+		FixedDateHoliday("Holiday", time.June, 1).Observed(ObserveSundayToMonday),
+	)
+
+	tests := []struct {
+		date string
+		want bool
+	}{
+		{"2024-06-01", true},
+		{"2024-05-31", false},
+		{"2024-06-03", false},
+		{"2025-06-02", true},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`RuleCalendar.IsHoliday(Date{"%s"})`, tt.date)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, cal.IsHoliday(MustParse(tt.date)))
+		})
+	}
+}
+
+// Conversion methods
+// --------------------------------------------------
+
+func TestRuleCalendarHolidayName(t *testing.T) {
+	tests := []struct {
+		date string
+		want string
+	}{
+		{"2024-05-27", "Memorial Day"},
+		{"2021-12-31", "New Year's Day"},
+		{"2024-06-05", ""},
+	}
+
+	cal := usFederalHolidays()
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`RuleCalendar.HolidayName(Date{"%s"})`, tt.date)
+
+		t.Run(testcase, func(t *testing.T) {
+			name, ok := cal.HolidayName(MustParse(tt.date))
+
+			assert.Equal(t, tt.want != "", ok)
+			assert.Equal(t, tt.want, name)
+		})
+	}
+}
+
+func TestRuleCalendarHolidaysInYear(t *testing.T) {
+	t.Run("RuleCalendar.HolidaysInYear(2021)", func(t *testing.T) {
+		want := []string{
+			"2021-01-01", "2021-01-18", "2021-05-31", "2021-06-18", "2021-06-19",
+			"2021-07-04", "2021-07-05", "2021-11-25", "2021-12-24", "2021-12-25",
+			"2021-12-31",
+		}
+
+		assert.Equal(t, want, usFederalHolidays().HolidaysInYear(2021).Strings())
+	})
+}
+
+func TestRuleCalendarHolidaysInMonth(t *testing.T) {
+	tests := []struct {
+		month Month
+		want  []string
+	}{
+		{NewMonth(2024, time.March), []string{"2024-03-29", "2024-03-31"}},
+		{NewMonth(2024, time.April), []string{"2024-04-01"}},
+		{NewMonth(2024, time.May), []string{}},
+	}
+
+	cal := NewRuleCalendar(
+		EasterHoliday("Good Friday", -2),
+		EasterHoliday("Easter Sunday", 0),
+		EasterHoliday("Easter Monday", 1),
+	)
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`RuleCalendar.HolidaysInMonth(Month{"%s"})`, tt.month)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, cal.HolidaysInMonth(tt.month).Strings())
+		})
+	}
+}
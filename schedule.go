@@ -0,0 +1,306 @@
+package date
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrInvalidScheduleFrequency = fmt.Errorf("schedule frequency must be positive")
+	ErrScheduleDatesCollapsed   = fmt.Errorf("adjusted schedule dates are not in ascending order")
+)
+
+// BusinessDayConvention specifies how a date falling on a non-business day is adjusted.
+type BusinessDayConvention int
+
+const (
+	// Unadjusted leaves dates as they are.
+	Unadjusted BusinessDayConvention = iota
+	// Following moves dates to the next business day.
+	Following
+	// ModifiedFollowing moves dates to the next business day, unless it is in the next month,
+	// in which case they are moved to the previous business day.
+	ModifiedFollowing
+	// Preceding moves dates to the previous business day.
+	Preceding
+	// ModifiedPreceding moves dates to the previous business day, unless it is in the previous month,
+	// in which case they are moved to the next business day.
+	ModifiedPreceding
+)
+
+// StubRule specifies where an irregular period is placed when the schedule does not divide evenly by the frequency.
+type StubRule int
+
+const (
+	// StubShortFinal generates dates forward from the start date and leaves a short final period.
+	StubShortFinal StubRule = iota
+	// StubLongFinal generates dates forward from the start date and merges the remainder into the final period.
+	StubLongFinal
+	// StubShortInitial generates dates backward from the end date and leaves a short initial period.
+	StubShortInitial
+	// StubLongInitial generates dates backward from the end date and merges the remainder into the initial period.
+	StubLongInitial
+)
+
+// ScheduleGenerator generates payment schedules with a fixed frequency.
+type ScheduleGenerator struct {
+	frequency  Period
+	stub       StubRule
+	convention BusinessDayConvention
+	calendar   Calendar
+	endOfMonth bool
+}
+
+// Schedule is the result of ScheduleGenerator, consisting of accrual periods and their payment dates.
+type Schedule struct {
+	unadjusted Dates
+	adjusted   Dates
+}
+
+// Conversion methods of BusinessDayConvention
+// --------------------------------------------------
+
+// Adjust adjusts the date according to the convention, treating weekends and holidays in the Calendar as non-business days.
+// A nil Calendar has no holidays.
+// It panics with ErrNoBusinessDay if the Calendar has no business day to adjust to.
+func (c BusinessDayConvention) Adjust(date Date, cal Calendar) Date {
+	if c == Unadjusted || date.IsBusinessDay(cal) {
+		return date
+	}
+
+	switch c {
+	case Following:
+		return date.NextBusinessDay(cal)
+	case ModifiedFollowing:
+		if next := date.NextBusinessDay(cal); next.Month() == date.Month() {
+			return next
+		}
+
+		return date.PreviousBusinessDay(cal)
+	case Preceding:
+		return date.PreviousBusinessDay(cal)
+	case ModifiedPreceding:
+		if previous := date.PreviousBusinessDay(cal); previous.Month() == date.Month() {
+			return previous
+		}
+
+		return date.NextBusinessDay(cal)
+	}
+
+	return date
+}
+
+// adjust adjusts the date in the same way as Adjust, but returns ErrNoBusinessDay instead of panicking.
+func (c BusinessDayConvention) adjust(date Date, cal Calendar) (adjusted Date, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			e, ok := r.(error)
+			if !ok || !errors.Is(e, ErrNoBusinessDay) {
+				panic(r)
+			}
+
+			err = e
+		}
+	}()
+
+	return c.Adjust(date, cal), nil
+}
+
+// String returns the name of the convention, such as "Modified Following".
+func (c BusinessDayConvention) String() string {
+	switch c {
+	case Unadjusted:
+		return "Unadjusted"
+	case Following:
+		return "Following"
+	case ModifiedFollowing:
+		return "Modified Following"
+	case Preceding:
+		return "Preceding"
+	case ModifiedPreceding:
+		return "Modified Preceding"
+	}
+
+	return "unknown"
+}
+
+// Factory functions
+// --------------------------------------------------
+
+// NewScheduleGenerator creates a new ScheduleGenerator instance with the specified frequency, such as P3M for quarterly payments.
+// By default, it leaves a short final stub and does not adjust dates.
+func NewScheduleGenerator(frequency Period) ScheduleGenerator {
+	return ScheduleGenerator{
+		frequency: frequency,
+	}
+}
+
+// Modifier methods
+// --------------------------------------------------
+
+// WithStub returns a copy of the ScheduleGenerator instance with the specified StubRule.
+func (g ScheduleGenerator) WithStub(stub StubRule) ScheduleGenerator {
+	g.stub = stub
+
+	return g
+}
+
+// WithConvention returns a copy of the ScheduleGenerator instance which adjusts dates
+// by the BusinessDayConvention against the Calendar.
+func (g ScheduleGenerator) WithConvention(convention BusinessDayConvention, cal Calendar) ScheduleGenerator {
+	g.convention = convention
+	g.calendar = cal
+
+	return g
+}
+
+// WithEndOfMonth returns a copy of the ScheduleGenerator instance which rolls dates on the last day of the month
+// when the date generation starts from the last day of a month.
+// It has no effect when the frequency has a days component.
+func (g ScheduleGenerator) WithEndOfMonth() ScheduleGenerator {
+	g.endOfMonth = true
+
+	return g
+}
+
+// Conversion methods
+// --------------------------------------------------
+
+// Generate generates the Schedule from start to end.
+// Dates are generated from the unadjusted start or end date depending on the StubRule,
+// by adding multiples of the frequency to avoid accumulating end-of-month clamping, and then adjusted.
+// It returns ErrNoBusinessDay if the Calendar has no business day to adjust a date to.
+func (g ScheduleGenerator) Generate(start, end Date) (Schedule, error) {
+	if g.frequency.IsZero() || g.frequency.IsNegative() {
+		return Schedule{}, fmt.Errorf("ScheduleGenerator.Generate: frequency %v: %w", g.frequency, ErrInvalidScheduleFrequency)
+	}
+	if !end.After(start) {
+		return Schedule{}, fmt.Errorf("ScheduleGenerator.Generate: %w", ErrEndDateIsBeforeStartDate)
+	}
+
+	var unadjusted Dates
+	switch g.stub {
+	case StubShortInitial, StubLongInitial:
+		unadjusted = g.rollBackward(start, end)
+	default:
+		unadjusted = g.rollForward(start, end)
+	}
+
+	adjusted := make(Dates, len(unadjusted))
+	for i, d := range unadjusted {
+		a, err := g.convention.adjust(d, g.calendar)
+		if err != nil {
+			return Schedule{}, fmt.Errorf("ScheduleGenerator.Generate: %w", err)
+		}
+		adjusted[i] = a
+
+		if i > 0 && !adjusted[i].After(adjusted[i-1]) {
+			return Schedule{}, fmt.Errorf("ScheduleGenerator.Generate: %v and %v: %w", unadjusted[i-1], d, ErrScheduleDatesCollapsed)
+		}
+	}
+
+	return Schedule{
+		unadjusted: unadjusted,
+		adjusted:   adjusted,
+	}, nil
+}
+
+// rollForward generates the unadjusted dates forward from start.
+func (g ScheduleGenerator) rollForward(start, end Date) Dates {
+	ds := Dates{start}
+
+	for n := 1; ; n++ {
+		d := g.roll(start, n)
+		if !d.Before(end) {
+			break
+		}
+
+		ds = append(ds, d)
+	}
+
+	if g.stub == StubLongFinal && len(ds) > 1 && !g.roll(start, len(ds)).Equal(end) {
+		ds = ds[:len(ds)-1]
+	}
+
+	return append(ds, end)
+}
+
+// rollBackward generates the unadjusted dates backward from end.
+func (g ScheduleGenerator) rollBackward(start, end Date) Dates {
+	ds := Dates{end}
+
+	for n := 1; ; n++ {
+		d := g.roll(end, -n)
+		if !d.After(start) {
+			break
+		}
+
+		ds = append(ds, d)
+	}
+
+	if g.stub == StubLongInitial && len(ds) > 1 && !g.roll(end, -len(ds)).Equal(start) {
+		ds = ds[:len(ds)-1]
+	}
+
+	return append(ds, start).SortMutable()
+}
+
+// roll returns the date n times the frequency away from the anchor date.
+func (g ScheduleGenerator) roll(anchor Date, n int) Date {
+	d := anchor.AddPeriod(g.frequency.Multiplied(n))
+
+	if g.endOfMonth && g.frequency.Days() == 0 && anchor.IsLastOfMonth() {
+		return d.EndOfMonth()
+	}
+
+	return d
+}
+
+// Conversion methods of Schedule
+// --------------------------------------------------
+
+// Len returns the number of periods in the Schedule instance.
+func (s Schedule) Len() int {
+	return max(len(s.adjusted)-1, 0)
+}
+
+// Dates returns the adjusted dates of the Schedule instance, from the start date to the end date.
+func (s Schedule) Dates() Dates {
+	return append(Dates{}, s.adjusted...)
+}
+
+// UnadjustedDates returns the dates of the Schedule instance before the business day adjustment.
+func (s Schedule) UnadjustedDates() Dates {
+	return append(Dates{}, s.unadjusted...)
+}
+
+// Periods returns the accrual periods of the Schedule instance.
+// Each period starts on an adjusted date and ends on the day before the next adjusted date.
+func (s Schedule) Periods() DateRanges {
+	return periodsBetween(s.adjusted)
+}
+
+// UnadjustedPeriods returns the accrual periods of the Schedule instance before the business day adjustment.
+func (s Schedule) UnadjustedPeriods() DateRanges {
+	return periodsBetween(s.unadjusted)
+}
+
+// PaymentDates returns the payment dates of the Schedule instance, which are the adjusted end dates of the periods.
+func (s Schedule) PaymentDates() Dates {
+	if len(s.adjusted) == 0 {
+		return Dates{}
+	}
+
+	return append(Dates{}, s.adjusted[1:]...)
+}
+
+// periodsBetween returns the DateRanges between the consecutive dates, excluding the later date of each pair.
+func periodsBetween(ds Dates) DateRanges {
+	drs := make(DateRanges, 0, len(ds))
+
+	for i := 1; i < len(ds); i++ {
+		drs = append(drs, DateRange{ds[i-1], ds[i].SubDay()})
+	}
+
+	return drs
+}
@@ -0,0 +1,223 @@
+package date
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBusinessDayConventionAdjust(t *testing.T) {
+	tests := []struct {
+		convention BusinessDayConvention
+		date       Date
+		cal        Calendar
+		want       string
+	}{
+		{Unadjusted, MustParse("2024-08-31"), nil, "2024-08-31"},
+		{Following, MustParse("2024-08-30"), nil, "2024-08-30"},
+		{Following, MustParse("2024-08-31"), nil, "2024-09-02"},
+		{Following, MustParse("2024-08-31"), testHolidays("2024-09-02"), "2024-09-03"},
+		{ModifiedFollowing, MustParse("2024-08-31"), nil, "2024-08-30"},
+		{ModifiedFollowing, MustParse("2024-06-01"), nil, "2024-06-03"},
+		{Preceding, MustParse("2024-06-01"), nil, "2024-05-31"},
+		{Preceding, MustParse("2024-06-03"), testHolidays("2024-06-03"), "2024-05-31"},
+		{ModifiedPreceding, MustParse("2024-06-01"), nil, "2024-06-03"},
+		{ModifiedPreceding, MustParse("2024-08-31"), nil, "2024-08-30"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`%s.Adjust(Date{"%s"})`, tt.convention, tt.date)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.convention.Adjust(tt.date, tt.cal).String())
+		})
+	}
+}
+
+func TestBusinessDayConventionAdjustWithoutBusinessDay(t *testing.T) {
+	cal := CalendarFunc(func(Date) bool { return true })
+
+	assert.Panics(t, func() { Following.Adjust(MustParse("2024-06-08"), cal) })
+	assert.NotPanics(t, func() { Unadjusted.Adjust(MustParse("2024-06-08"), cal) })
+}
+
+func TestBusinessDayConventionString(t *testing.T) {
+	tests := []struct {
+		convention BusinessDayConvention
+		want       string
+	}{
+		{Unadjusted, "Unadjusted"},
+		{Following, "Following"},
+		{ModifiedFollowing, "Modified Following"},
+		{Preceding, "Preceding"},
+		{ModifiedPreceding, "Modified Preceding"},
+		{BusinessDayConvention(-1), "unknown"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf("BusinessDayConvention(%d).String()", tt.convention)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.convention.String())
+		})
+	}
+}
+
+func TestScheduleGeneratorGenerate(t *testing.T) {
+	tests := []struct {
+		name      string
+		generator ScheduleGenerator
+		start     Date
+		end       Date
+		want      []string
+	}{
+		{
+			"quarterly from the end of January",
+			NewScheduleGenerator(NewPeriod(0, 3, 0)),
+			MustParse("2024-01-31"),
+			MustParse("2025-01-31"),
+			[]string{"2024-01-31", "2024-04-30", "2024-07-31", "2024-10-31", "2025-01-31"},
+		},
+		{
+			"monthly from the end of February",
+			NewScheduleGenerator(NewPeriod(0, 1, 0)),
+			MustParse("2024-02-29"),
+			MustParse("2024-05-31"),
+			[]string{"2024-02-29", "2024-03-29", "2024-04-29", "2024-05-29", "2024-05-31"},
+		},
+		{
+			"monthly from the end of February with end of month",
+			NewScheduleGenerator(NewPeriod(0, 1, 0)).WithEndOfMonth(),
+			MustParse("2024-02-29"),
+			MustParse("2024-05-31"),
+			[]string{"2024-02-29", "2024-03-31", "2024-04-30", "2024-05-31"},
+		},
+		{
+			"short final stub",
+			NewScheduleGenerator(NewPeriod(0, 3, 0)).WithStub(StubShortFinal),
+			MustParse("2024-01-15"),
+			MustParse("2024-11-30"),
+			[]string{"2024-01-15", "2024-04-15", "2024-07-15", "2024-10-15", "2024-11-30"},
+		},
+		{
+			"long final stub",
+			NewScheduleGenerator(NewPeriod(0, 3, 0)).WithStub(StubLongFinal),
+			MustParse("2024-01-15"),
+			MustParse("2024-11-30"),
+			[]string{"2024-01-15", "2024-04-15", "2024-07-15", "2024-11-30"},
+		},
+		{
+			"short initial stub",
+			NewScheduleGenerator(NewPeriod(0, 3, 0)).WithStub(StubShortInitial),
+			MustParse("2024-01-15"),
+			MustParse("2024-11-30"),
+			[]string{"2024-01-15", "2024-02-29", "2024-05-30", "2024-08-30", "2024-11-30"},
+		},
+		{
+			"long initial stub",
+			NewScheduleGenerator(NewPeriod(0, 3, 0)).WithStub(StubLongInitial),
+			MustParse("2024-01-15"),
+			MustParse("2024-11-30"),
+			[]string{"2024-01-15", "2024-05-30", "2024-08-30", "2024-11-30"},
+		},
+		{
+			"long final stub without remainder",
+			NewScheduleGenerator(NewPeriod(0, 6, 0)).WithStub(StubLongFinal),
+			MustParse("2024-01-15"),
+			MustParse("2025-01-15"),
+			[]string{"2024-01-15", "2024-07-15", "2025-01-15"},
+		},
+		{
+			"long initial stub shorter than the frequency",
+			NewScheduleGenerator(NewPeriod(1, 0, 0)).WithStub(StubLongInitial),
+			MustParse("2024-01-15"),
+			MustParse("2024-06-30"),
+			[]string{"2024-01-15", "2024-06-30"},
+		},
+		{
+			"biweekly",
+			NewScheduleGenerator(NewPeriod(0, 0, 14)),
+			MustParse("2024-01-01"),
+			MustParse("2024-02-01"),
+			[]string{"2024-01-01", "2024-01-15", "2024-01-29", "2024-02-01"},
+		},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`ScheduleGenerator{%s}.Generate(Date{"%s"}, Date{"%s"})`, tt.name, tt.start, tt.end)
+
+		t.Run(testcase, func(t *testing.T) {
+			s, err := tt.generator.Generate(tt.start, tt.end)
+			assert.Nil(t, err, "Expected no error, got %v", err)
+			assert.Equal(t, tt.want, s.UnadjustedDates().Strings())
+			assert.Equal(t, tt.want, s.Dates().Strings())
+			assert.Equal(t, len(tt.want)-1, s.Len())
+		})
+	}
+}
+
+func TestScheduleGeneratorGenerateWithConvention(t *testing.T) {
+	t.Run("ScheduleGenerator{monthly, Modified Following}.Generate()", func(t *testing.T) {
+		g := NewScheduleGenerator(NewPeriod(0, 1, 0)).
+			WithEndOfMonth().
+			WithConvention(ModifiedFollowing, testHolidays("2024-05-31"))
+
+		s, err := g.Generate(MustParse("2024-03-31"), MustParse("2024-07-31"))
+		assert.Nil(t, err, "Expected no error, got %v", err)
+
+		assert.Equal(t, []string{"2024-03-31", "2024-04-30", "2024-05-31", "2024-06-30", "2024-07-31"}, s.UnadjustedDates().Strings())
+		assert.Equal(t, []string{"2024-03-29", "2024-04-30", "2024-05-30", "2024-06-28", "2024-07-31"}, s.Dates().Strings())
+		assert.Equal(t, []string{"2024-04-30", "2024-05-30", "2024-06-28", "2024-07-31"}, s.PaymentDates().Strings())
+		assert.Equal(
+			t,
+			[]string{"2024-03-29/2024-04-29", "2024-04-30/2024-05-29", "2024-05-30/2024-06-27", "2024-06-28/2024-07-30"},
+			s.Periods().Strings(),
+		)
+		assert.Equal(
+			t,
+			[]string{"2024-03-31/2024-04-29", "2024-04-30/2024-05-30", "2024-05-31/2024-06-29", "2024-06-30/2024-07-30"},
+			s.UnadjustedPeriods().Strings(),
+		)
+	})
+}
+
+func TestScheduleGeneratorGenerateErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		generator ScheduleGenerator
+		start     Date
+		end       Date
+		wantErr   error
+	}{
+		{"zero frequency", NewScheduleGenerator(ZeroPeriod()), MustParse("2024-01-01"), MustParse("2024-12-31"), ErrInvalidScheduleFrequency},
+		{"negative frequency", NewScheduleGenerator(NewPeriod(0, -1, 0)), MustParse("2024-01-01"), MustParse("2024-12-31"), ErrInvalidScheduleFrequency},
+		{"same dates", NewScheduleGenerator(NewPeriod(0, 1, 0)), MustParse("2024-01-01"), MustParse("2024-01-01"), ErrEndDateIsBeforeStartDate},
+		{"reversed dates", NewScheduleGenerator(NewPeriod(0, 1, 0)), MustParse("2024-12-31"), MustParse("2024-01-01"), ErrEndDateIsBeforeStartDate},
+		{
+			"collapsed dates",
+			NewScheduleGenerator(NewPeriod(0, 0, 1)).WithConvention(Following, nil),
+			MustParse("2024-03-01"),
+			MustParse("2024-03-04"),
+			ErrScheduleDatesCollapsed,
+		},
+		{
+			"no business day",
+			NewScheduleGenerator(NewPeriod(0, 1, 0)).WithConvention(Following, CalendarFunc(func(Date) bool { return true })),
+			MustParse("2024-01-01"),
+			MustParse("2024-12-31"),
+			ErrNoBusinessDay,
+		},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`ScheduleGenerator{%s}.Generate(Date{"%s"}, Date{"%s"})`, tt.name, tt.start, tt.end)
+
+		t.Run(testcase, func(t *testing.T) {
+			s, err := tt.generator.Generate(tt.start, tt.end)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, 0, s.Len())
+			assert.Empty(t, s.PaymentDates())
+		})
+	}
+}
@@ -1,14 +1,16 @@
 package date
 
 import (
+	"sync"
 	"time"
 )
 
 const iso8601 = "2006-01-02T15:04:05.999999999+09:00"
 
 var (
-	now      = time.Now
-	location = func() *time.Location { return time.Local }
+	mockMu       sync.RWMutex
+	nowFunc      = time.Now
+	locationFunc = func() *time.Location { return time.Local }
 )
 
 // Now returns the current time, which can be mocked using SetTestNow.
@@ -18,26 +20,57 @@ func Now() time.Time {
 
 // SetTestNow sets a custom function to return the current time.
 // This function is used to mock the current time in tests.
+// It affects the whole package, so use a Clock for tests that run in parallel.
 func SetTestNow(getNow func() time.Time) {
-	now = getNow
+	mockMu.Lock()
+	defer mockMu.Unlock()
+
+	nowFunc = getNow
 }
 
 // ResetTestNow resets the function to return the current time to the default.
 // This function is used to reset the mocked current time to its original value.
 func ResetTestNow() {
-	now = time.Now
+	mockMu.Lock()
+	defer mockMu.Unlock()
+
+	nowFunc = time.Now
 }
 
 // SetTestLocation sets a custom function to return the current location.
 // This function is used to mock the current location in tests.
 func SetTestLocation(getLocation func() *time.Location) {
-	location = getLocation
+	mockMu.Lock()
+	defer mockMu.Unlock()
+
+	locationFunc = getLocation
 }
 
 // ResetTestLocation resets the function to return the current location to the default.
 // This function is used to reset the mocked current location to its original value.
 func ResetTestLocation() {
-	location = func() *time.Location { return time.Local }
+	mockMu.Lock()
+	defer mockMu.Unlock()
+
+	locationFunc = func() *time.Location { return time.Local }
+}
+
+// now returns the current time using the function set by SetTestNow.
+func now() time.Time {
+	mockMu.RLock()
+	getNow := nowFunc
+	mockMu.RUnlock()
+
+	return getNow()
+}
+
+// location returns the current location using the function set by SetTestLocation.
+func location() *time.Location {
+	mockMu.RLock()
+	getLocation := locationFunc
+	mockMu.RUnlock()
+
+	return getLocation()
 }
 
 // today returns the current date with the time set to the start of the day.
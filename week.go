@@ -0,0 +1,346 @@
+package date
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrEndWeekIsBeforeStartWeek = fmt.Errorf("end week is before start week")
+	ErrInvalidWeek              = fmt.Errorf("invalid ISO week")
+)
+
+type Week struct {
+	y int // ISO year -1
+	w int // ISO week -1
+}
+
+// Factory functions
+// --------------------------------------------------
+
+// NewWeek creates a new Week instance with the specified ISO 8601 year and week number.
+// If the week number exceeds the number of weeks in the year, it rolls over to the next year.
+func NewWeek(year, week int) Week {
+	return WeekFromDate(isoWeekOneMonday(year).AddWeeks(week - 1))
+}
+
+// ZeroWeek returns a zero value Week instance.
+func ZeroWeek() Week {
+	return Week{}
+}
+
+// WeekFromDate creates a new Week instance from a Date instance.
+func WeekFromDate(date Date) Week {
+	year, week := date.ISOWeek()
+
+	return Week{year - 1, week - 1}
+}
+
+// WeekFromTime creates a new Week instance from a time.Time value.
+func WeekFromTime(time time.Time) Week {
+	year, week := time.ISOWeek()
+
+	return Week{year - 1, week - 1}
+}
+
+// ParseWeek parses an ISO 8601 week string in the format "2006-W01" and returns a Week instance.
+func ParseWeek(value string) (Week, error) {
+	year, week, ok := strings.Cut(value, "-W")
+	if !ok || len(year) < 4 || len(week) != 2 {
+		return ZeroWeek(), fmt.Errorf("ParseWeek: failed to parse week %q: %w", value, ErrInvalidWeek)
+	}
+
+	y, err := strconv.Atoi(year)
+	if err != nil {
+		return ZeroWeek(), fmt.Errorf("ParseWeek: failed to parse year of week %q: %w", value, ErrInvalidWeek)
+	}
+
+	w, err := strconv.Atoi(week)
+	if err != nil || w < 1 || w > isoWeeksInYear(y) {
+		return ZeroWeek(), fmt.Errorf("ParseWeek: failed to parse week of week %q: %w", value, ErrInvalidWeek)
+	}
+
+	return Week{y - 1, w - 1}, nil
+}
+
+// MustParseWeek parses an ISO 8601 week string in the format "2006-W01" and returns a Week instance.
+// It panics if the parsing fails.
+func MustParseWeek(value string) Week {
+	w, err := ParseWeek(value)
+	if err != nil {
+		panic(err)
+	}
+
+	return w
+}
+
+// CurrentWeek returns the current week.
+func CurrentWeek() Week {
+	return WeekFromDate(Today())
+}
+
+// NextWeek returns the next week.
+func NextWeek() Week {
+	return CurrentWeek().AddWeek()
+}
+
+// LastWeek returns the previous week.
+func LastWeek() Week {
+	return CurrentWeek().SubWeek()
+}
+
+// Determination methods
+// --------------------------------------------------
+
+// IsZero checks if the Week instance is a zero value.
+func (w Week) IsZero() bool {
+	zw := ZeroWeek()
+
+	return w.Equal(zw)
+}
+
+// IsPast checks if the Week instance is in the past.
+func (w Week) IsPast() bool {
+	return w.IsPastWith(DefaultClock())
+}
+
+// IsPastWith checks if the Week instance is in the past according to the specified Clock.
+func (w Week) IsPastWith(c Clock) bool {
+	return w.Before(WeekFromDate(c.Today()))
+}
+
+// IsFuture checks if the Week instance is in the future.
+func (w Week) IsFuture() bool {
+	return w.IsFutureWith(DefaultClock())
+}
+
+// IsFutureWith checks if the Week instance is in the future according to the specified Clock.
+func (w Week) IsFutureWith(c Clock) bool {
+	return w.After(WeekFromDate(c.Today()))
+}
+
+// IsCurrentWeek checks if the Week instance is the current week.
+func (w Week) IsCurrentWeek() bool {
+	return w.IsCurrentWeekWith(DefaultClock())
+}
+
+// IsCurrentWeekWith checks if the Week instance is the current week according to the specified Clock.
+func (w Week) IsCurrentWeekWith(c Clock) bool {
+	return w.Equal(WeekFromDate(c.Today()))
+}
+
+// IsNextWeek checks if the Week instance is the next week.
+func (w Week) IsNextWeek() bool {
+	return w.IsNextWeekWith(DefaultClock())
+}
+
+// IsNextWeekWith checks if the Week instance is the next week according to the specified Clock.
+func (w Week) IsNextWeekWith(c Clock) bool {
+	return w.Equal(WeekFromDate(c.Today()).AddWeek())
+}
+
+// IsLastWeek checks if the Week instance is the previous week.
+func (w Week) IsLastWeek() bool {
+	return w.IsLastWeekWith(DefaultClock())
+}
+
+// IsLastWeekWith checks if the Week instance is the previous week according to the specified Clock.
+func (w Week) IsLastWeekWith(c Clock) bool {
+	return w.Equal(WeekFromDate(c.Today()).SubWeek())
+}
+
+// Comparison methods
+// --------------------------------------------------
+
+// Compare compares the Week instance with another Week instance.
+// It returns 1 if the Week instance is after the other Week, 0 if they are equal, and -1 if it is before.
+func (w Week) Compare(week Week) int {
+	if w.After(week) {
+		return 1
+	} else if w.Equal(week) {
+		return 0
+	}
+
+	return -1
+}
+
+// Equal checks if the Week instance is equal to another Week instance.
+func (w Week) Equal(week Week) bool {
+	return w.y == week.y && w.w == week.w
+}
+
+// NotEqual checks if the Week instance is not equal to another Week instance.
+func (w Week) NotEqual(week Week) bool {
+	return !w.Equal(week)
+}
+
+// After checks if the Week instance is after another Week instance.
+func (w Week) After(week Week) bool {
+	return w.y > week.y ||
+		w.y == week.y && w.w > week.w
+}
+
+// AfterOrEqual checks if the Week instance is after or equal to another Week instance.
+func (w Week) AfterOrEqual(week Week) bool {
+	return w.Equal(week) || w.After(week)
+}
+
+// Before checks if the Week instance is before another Week instance.
+func (w Week) Before(week Week) bool {
+	return w.y < week.y ||
+		w.y == week.y && w.w < week.w
+}
+
+// BeforeOrEqual checks if the Week instance is before or equal to another Week instance.
+func (w Week) BeforeOrEqual(week Week) bool {
+	return w.Equal(week) || w.Before(week)
+}
+
+// Between checks if the Week instance is between two other Week instances.
+func (w Week) Between(start, end Week) (bool, error) {
+	if start.After(end) {
+		return false, fmt.Errorf(
+			"Between: end week %v is before start week %v: %w",
+			end,
+			start,
+			ErrEndWeekIsBeforeStartWeek,
+		)
+	}
+
+	return start.BeforeOrEqual(w) && end.AfterOrEqual(w), nil
+}
+
+// Addition and Subtraction methods
+// --------------------------------------------------
+
+// AddWeek adds one week to the Week instance.
+func (w Week) AddWeek() Week {
+	return w.AddWeeks(1)
+}
+
+// AddWeeks adds the specified number of weeks to the Week instance.
+func (w Week) AddWeeks(weeks int) Week {
+	return WeekFromDate(w.FirstDate().AddWeeks(weeks))
+}
+
+// SubWeek subtracts one week from the Week instance.
+func (w Week) SubWeek() Week {
+	return w.SubWeeks(1)
+}
+
+// SubWeeks subtracts the specified number of weeks from the Week instance.
+func (w Week) SubWeeks(weeks int) Week {
+	return w.AddWeeks(weeks * -1)
+}
+
+// Conversion methods
+// --------------------------------------------------
+
+// Year returns the ISO 8601 year of the Week instance.
+// It may differ from the calendar year of its first or last date.
+func (w Week) Year() int {
+	return w.y + 1
+}
+
+// Week returns the ISO 8601 week number of the Week instance.
+func (w Week) Week() int {
+	return w.w + 1
+}
+
+// FirstDate returns the first date, which is a Monday, of the Week instance.
+func (w Week) FirstDate() Date {
+	return isoWeekOneMonday(w.Year()).AddWeeks(w.w)
+}
+
+// LastDate returns the last date, which is a Sunday, of the Week instance.
+func (w Week) LastDate() Date {
+	return w.FirstDate().AddDays(6)
+}
+
+// On returns the Date of the specified weekday in the Week instance.
+func (w Week) On(weekday time.Weekday) Date {
+	return w.FirstDate().AddDays((int(weekday) + 6) % 7)
+}
+
+// ToDateRange converts the Week instance to a DateRange instance.
+func (w Week) ToDateRange() DateRange {
+	r, _ := NewDateRange(w.FirstDate(), w.LastDate())
+
+	return r
+}
+
+// Dates returns the Dates within the Week instance.
+func (w Week) Dates() Dates {
+	return w.ToDateRange().Dates()
+}
+
+// Split splits the Week instance into ISO 8601 year and week number components.
+func (w Week) Split() (int, int) {
+	return w.Year(), w.Week()
+}
+
+// String returns the string representation of the Week instance in the format "2006-W01".
+func (w Week) String() string {
+	f := "%04d-W%02d"
+	if w.Year() < 0 {
+		f = "%05d-W%02d"
+	}
+
+	return fmt.Sprintf(f, w.Year(), w.Week())
+}
+
+// Marshalling methods
+// --------------------------------------------------
+
+// MarshalText marshals the Week instance to a text representation.
+func (w *Week) MarshalText() ([]byte, error) {
+	return []byte(w.String()), nil
+}
+
+// UnmarshalText unmarshals a text representation into the Week instance.
+func (w *Week) UnmarshalText(text []byte) error {
+	week, err := ParseWeek(string(text))
+	if err != nil {
+		return fmt.Errorf("Week.UnmarshalText: %w", err)
+	}
+
+	w.y, w.w = week.y, week.w
+
+	return nil
+}
+
+// MarshalJSON marshals the Week instance to a JSON representation.
+func (w Week) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + w.String() + `"`), nil
+}
+
+// UnmarshalJSON unmarshals a JSON representation into the Week instance.
+func (w *Week) UnmarshalJSON(json []byte) error {
+	value := strings.Trim(string(json), `"`)
+
+	week, err := ParseWeek(value)
+	if err != nil {
+		return fmt.Errorf("Week.UnmarshalJSON: %w", err)
+	}
+
+	w.y, w.w = week.y, week.w
+
+	return nil
+}
+
+// isoWeekOneMonday returns the Monday of the first ISO 8601 week of the specified year,
+// which is the week containing January 4.
+func isoWeekOneMonday(year int) Date {
+	jan4 := NewDate(year, time.January, 4)
+
+	return jan4.SubDays((int(jan4.Weekday()) + 6) % 7)
+}
+
+// isoWeeksInYear returns the number of ISO 8601 weeks in the specified year, which is 52 or 53.
+func isoWeeksInYear(year int) int {
+	_, week := NewDate(year, time.December, 28).ISOWeek()
+
+	return week
+}
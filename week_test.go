@@ -0,0 +1,575 @@
+package date
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Factory functions
+// --------------------------------------------------
+
+func TestNewWeek(t *testing.T) {
+	tests := []struct {
+		year int
+		week int
+		want string
+	}{
+		{2024, 11, "2024-W11"},
+		{2020, 53, "2020-W53"},
+		{2024, 53, "2025-W01"},
+		{2024, 0, "2023-W52"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf("NewWeek(%d, %d)", tt.year, tt.week)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, NewWeek(tt.year, tt.week).String())
+		})
+	}
+}
+
+func TestZeroWeek(t *testing.T) {
+	t.Run("ZeroWeek()", func(t *testing.T) {
+		week := ZeroWeek()
+		assert.True(t, week.IsZero(), "Week created was not zero value")
+		assert.Equal(t, "0001-W01", week.String())
+		assert.Equal(t, "0001-01-01", week.FirstDate().String())
+	})
+}
+
+func TestWeekFromDate(t *testing.T) {
+	tests := []struct {
+		date Date
+		want string
+	}{
+		{MustParse("2024-03-11"), "2024-W11"},
+		{MustParse("2024-03-17"), "2024-W11"},
+		{MustParse("2021-01-03"), "2020-W53"},
+		{MustParse("2024-12-30"), "2025-W01"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`WeekFromDate(Date{"%s"})`, tt.date)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, WeekFromDate(tt.date).String())
+		})
+	}
+}
+
+func TestWeekFromTime(t *testing.T) {
+	tests := []struct {
+		time time.Time
+		want string
+	}{
+		{time.Date(2024, time.March, 11, 0, 0, 0, 0, time.UTC), "2024-W11"},
+		{time.Date(2021, time.January, 3, 23, 59, 59, 0, time.Local), "2020-W53"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`WeekFromTime(Time{%s})`, tt.time.Format(iso8601))
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, WeekFromTime(tt.time).String())
+		})
+	}
+}
+
+func TestParseWeek(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{"2024-W11", "2024-W11"},
+		{"2020-W53", "2020-W53"},
+		{"2024-W53", "error"},
+		{"2024-W00", "error"},
+		{"2024-W1", "error"},
+		{"2024W11", "error"},
+		{"2024-11", "error"},
+		{"abcd-W11", "error"},
+		{"2024-Wxx", "error"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`ParseWeek("%s")`, tt.value)
+
+		t.Run(testcase, func(t *testing.T) {
+			week, err := ParseWeek(tt.value)
+			if tt.want == "error" {
+				assert.Error(t, err)
+			} else {
+				assert.Nil(t, err, "An unexpected error was returned")
+				assert.Equal(t, tt.want, week.String())
+			}
+		})
+	}
+}
+
+func TestMustParseWeek(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{"2024-W11", "2024-W11"},
+		{"2024-W53", "panic"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`MustParseWeek("%s")`, tt.value)
+
+		t.Run(testcase, func(t *testing.T) {
+			if tt.want == "panic" {
+				assert.Panics(t, func() { MustParseWeek(tt.value) }, "Did not panic")
+			} else {
+				assert.Equal(t, tt.want, MustParseWeek(tt.value).String())
+			}
+		})
+	}
+}
+
+func TestCurrentWeek(t *testing.T) {
+	tests := []struct {
+		now  time.Time
+		want string
+	}{
+		{time.Date(2024, time.March, 11, 0, 0, 0, 0, time.Local), "2024-W11"},
+		{time.Date(2024, time.March, 17, 23, 59, 59, 999, time.Local), "2024-W11"},
+		{time.Date(2024, time.December, 31, 12, 0, 0, 0, time.Local), "2025-W01"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`CurrentWeek() at %s`, tt.now.Format(iso8601))
+
+		t.Run(testcase, func(t *testing.T) {
+			SetTestNow(func() time.Time { return tt.now })
+			defer ResetTestNow()
+
+			assert.Equal(t, tt.want, CurrentWeek().String())
+		})
+	}
+}
+
+func TestNextWeek(t *testing.T) {
+	t.Run("NextWeek()", func(t *testing.T) {
+		SetTestNow(func() time.Time { return time.Date(2020, time.December, 22, 0, 0, 0, 0, time.Local) })
+		defer ResetTestNow()
+
+		assert.Equal(t, "2020-W53", NextWeek().String())
+	})
+}
+
+func TestLastWeek(t *testing.T) {
+	t.Run("LastWeek()", func(t *testing.T) {
+		SetTestNow(func() time.Time { return time.Date(2021, time.January, 4, 0, 0, 0, 0, time.Local) })
+		defer ResetTestNow()
+
+		assert.Equal(t, "2020-W53", LastWeek().String())
+	})
+}
+
+// Determination methods
+// --------------------------------------------------
+
+func TestWeekIsZero(t *testing.T) {
+	tests := []struct {
+		week Week
+		want bool
+	}{
+		{ZeroWeek(), true},
+		{MustParseWeek("2024-W11"), false},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Week{"%s"}.IsZero()`, tt.week)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.week.IsZero())
+		})
+	}
+}
+
+func TestWeekRelativeDeterminations(t *testing.T) {
+	tests := []struct {
+		week                              Week
+		past, future, current, next, last bool
+	}{
+		{MustParseWeek("2024-W10"), true, false, false, false, true},
+		{MustParseWeek("2024-W11"), false, false, true, false, false},
+		{MustParseWeek("2024-W12"), false, true, false, true, false},
+		{MustParseWeek("2024-W13"), false, true, false, false, false},
+	}
+
+	for _, tt := range tests {
+		SetTestNow(func() time.Time { return time.Date(2024, time.March, 13, 12, 0, 0, 0, time.Local) })
+		defer ResetTestNow()
+
+		testcase := fmt.Sprintf(`Week{"%s"} at %s`, tt.week, now().Format(iso8601))
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.past, tt.week.IsPast(), "IsPast")
+			assert.Equal(t, tt.future, tt.week.IsFuture(), "IsFuture")
+			assert.Equal(t, tt.current, tt.week.IsCurrentWeek(), "IsCurrentWeek")
+			assert.Equal(t, tt.next, tt.week.IsNextWeek(), "IsNextWeek")
+			assert.Equal(t, tt.last, tt.week.IsLastWeek(), "IsLastWeek")
+		})
+	}
+}
+
+// Comparison methods
+// --------------------------------------------------
+
+func TestWeekComparisons(t *testing.T) {
+	tests := []struct {
+		week, target                                             Week
+		compare                                                  int
+		equal, after, afterOrEqual, before, beforeOrEqual, notEq bool
+	}{
+		{MustParseWeek("2024-W11"), MustParseWeek("2024-W11"), 0, true, false, true, false, true, false},
+		{MustParseWeek("2024-W11"), MustParseWeek("2024-W10"), 1, false, true, true, false, false, true},
+		{MustParseWeek("2024-W11"), MustParseWeek("2024-W12"), -1, false, false, false, true, true, true},
+		{MustParseWeek("2024-W01"), MustParseWeek("2020-W53"), 1, false, true, true, false, false, true},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Week{"%s"} vs Week{"%s"}`, tt.week, tt.target)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.compare, tt.week.Compare(tt.target), "Compare")
+			assert.Equal(t, tt.equal, tt.week.Equal(tt.target), "Equal")
+			assert.Equal(t, tt.notEq, tt.week.NotEqual(tt.target), "NotEqual")
+			assert.Equal(t, tt.after, tt.week.After(tt.target), "After")
+			assert.Equal(t, tt.afterOrEqual, tt.week.AfterOrEqual(tt.target), "AfterOrEqual")
+			assert.Equal(t, tt.before, tt.week.Before(tt.target), "Before")
+			assert.Equal(t, tt.beforeOrEqual, tt.week.BeforeOrEqual(tt.target), "BeforeOrEqual")
+		})
+	}
+}
+
+func TestWeekBetween(t *testing.T) {
+	tests := []struct {
+		week    Week
+		start   Week
+		end     Week
+		want    bool
+		wantErr error
+	}{
+		{MustParseWeek("2024-W11"), MustParseWeek("2024-W10"), MustParseWeek("2024-W12"), true, nil},
+		{MustParseWeek("2024-W11"), MustParseWeek("2024-W11"), MustParseWeek("2024-W11"), true, nil},
+		{MustParseWeek("2024-W11"), MustParseWeek("2024-W12"), MustParseWeek("2024-W13"), false, nil},
+		{MustParseWeek("2024-W11"), MustParseWeek("2024-W12"), MustParseWeek("2024-W10"), false, ErrEndWeekIsBeforeStartWeek},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Week{"%s"}.Between(Week{"%s"},Week{"%s"})`, tt.week, tt.start, tt.end)
+
+		t.Run(testcase, func(t *testing.T) {
+			b, err := tt.week.Between(tt.start, tt.end)
+			assert.Equal(t, tt.want, b)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.Nil(t, err, "Expected no error, got %v", err)
+			}
+		})
+	}
+}
+
+// Addition and Subtraction methods
+// --------------------------------------------------
+
+func TestWeekAddWeek(t *testing.T) {
+	tests := []struct {
+		week Week
+		want string
+	}{
+		{MustParseWeek("2024-W11"), "2024-W12"},
+		{MustParseWeek("2020-W52"), "2020-W53"},
+		{MustParseWeek("2020-W53"), "2021-W01"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Week{"%s"}.AddWeek()`, tt.week)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.week.AddWeek().String())
+		})
+	}
+}
+
+func TestWeekAddWeeks(t *testing.T) {
+	tests := []struct {
+		week  Week
+		weeks int
+		want  string
+	}{
+		{MustParseWeek("2024-W11"), 0, "2024-W11"},
+		{MustParseWeek("2024-W11"), 52, "2025-W11"},
+		{MustParseWeek("2024-W11"), -11, "2023-W52"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Week{"%s"}.AddWeeks(%d)`, tt.week, tt.weeks)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.week.AddWeeks(tt.weeks).String())
+		})
+	}
+}
+
+func TestWeekSubWeek(t *testing.T) {
+	tests := []struct {
+		week Week
+		want string
+	}{
+		{MustParseWeek("2024-W11"), "2024-W10"},
+		{MustParseWeek("2021-W01"), "2020-W53"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Week{"%s"}.SubWeek()`, tt.week)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.week.SubWeek().String())
+		})
+	}
+}
+
+func TestWeekSubWeeks(t *testing.T) {
+	tests := []struct {
+		week  Week
+		weeks int
+		want  string
+	}{
+		{MustParseWeek("2024-W11"), 11, "2023-W52"},
+		{MustParseWeek("2024-W11"), -1, "2024-W12"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Week{"%s"}.SubWeeks(%d)`, tt.week, tt.weeks)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.week.SubWeeks(tt.weeks).String())
+		})
+	}
+}
+
+// Conversion methods
+// --------------------------------------------------
+
+func TestWeekYearAndWeek(t *testing.T) {
+	tests := []struct {
+		week     Week
+		wantYear int
+		wantWeek int
+	}{
+		{MustParseWeek("2024-W11"), 2024, 11},
+		{MustParse("2021-01-03").ToWeek(), 2020, 53},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Week{"%s"}.Split()`, tt.week)
+
+		t.Run(testcase, func(t *testing.T) {
+			year, week := tt.week.Split()
+
+			assert.Equal(t, tt.wantYear, tt.week.Year())
+			assert.Equal(t, tt.wantWeek, tt.week.Week())
+			assert.Equal(t, tt.wantYear, year)
+			assert.Equal(t, tt.wantWeek, week)
+		})
+	}
+}
+
+func TestWeekFirstDateAndLastDate(t *testing.T) {
+	tests := []struct {
+		week      Week
+		wantFirst string
+		wantLast  string
+	}{
+		{MustParseWeek("2024-W01"), "2024-01-01", "2024-01-07"},
+		{MustParseWeek("2024-W11"), "2024-03-11", "2024-03-17"},
+		{MustParseWeek("2020-W53"), "2020-12-28", "2021-01-03"},
+		{MustParseWeek("2025-W01"), "2024-12-30", "2025-01-05"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Week{"%s"}.FirstDate()/LastDate()`, tt.week)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.wantFirst, tt.week.FirstDate().String())
+			assert.Equal(t, tt.wantLast, tt.week.LastDate().String())
+		})
+	}
+}
+
+func TestWeekOn(t *testing.T) {
+	tests := []struct {
+		weekday time.Weekday
+		want    string
+	}{
+		{time.Monday, "2024-03-11"},
+		{time.Wednesday, "2024-03-13"},
+		{time.Sunday, "2024-03-17"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Week{"2024-W11"}.On(%s)`, tt.weekday)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, MustParseWeek("2024-W11").On(tt.weekday).String())
+		})
+	}
+}
+
+func TestWeekToDateRange(t *testing.T) {
+	t.Run(`Week{"2024-W11"}.ToDateRange()`, func(t *testing.T) {
+		assert.Equal(t, "2024-03-11/2024-03-17", MustParseWeek("2024-W11").ToDateRange().String())
+	})
+}
+
+func TestWeekDates(t *testing.T) {
+	t.Run(`Week{"2024-W11"}.Dates()`, func(t *testing.T) {
+		dates := MustParseWeek("2024-W11").Dates()
+
+		assert.Len(t, dates, 7)
+		assert.Equal(t, "2024-03-11", dates[0].String())
+		assert.Equal(t, "2024-03-17", dates[6].String())
+	})
+}
+
+func TestWeekString(t *testing.T) {
+	tests := []struct {
+		week Week
+		want string
+	}{
+		{MustParseWeek("2024-W01"), "2024-W01"},
+		{NewWeek(-1, 10), "-0001-W10"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Week{%d,%d}.String()`, tt.week.Year(), tt.week.Week())
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.week.String())
+		})
+	}
+}
+
+func TestDateToWeek(t *testing.T) {
+	tests := []struct {
+		date Date
+		want Week
+	}{
+		{MustParse("2024-03-13"), MustParseWeek("2024-W11")},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Date{"%s"}.ToWeek()`, tt.date)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.date.ToWeek())
+		})
+	}
+}
+
+// Marshalling methods
+// --------------------------------------------------
+
+func TestWeekMarshalText(t *testing.T) {
+	tests := []struct {
+		week Week
+		want string
+	}{
+		{MustParseWeek("2024-W11"), "2024-W11"},
+		{ZeroWeek(), "0001-W01"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Week{"%s"}.MarshalText()`, tt.week)
+
+		t.Run(testcase, func(t *testing.T) {
+			text, err := tt.week.MarshalText()
+			assert.Nil(t, err, "Excepted no error, got %v", err)
+			assert.Equal(t, tt.want, string(text))
+		})
+	}
+}
+
+func TestWeekUnmarshalText(t *testing.T) {
+	tests := []struct {
+		text []byte
+		want string
+	}{
+		{[]byte("2024-W11"), "2024-W11"},
+		{[]byte{}, "error"},
+		{[]byte("invalid"), "error"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Week{}.UnmarshalText("%s")`, tt.text)
+
+		t.Run(testcase, func(t *testing.T) {
+			w := ZeroWeek()
+			err := w.UnmarshalText(tt.text)
+			if tt.want == "error" {
+				assert.Error(t, err, "Unable to parse")
+				assert.True(t, w.IsZero(), "week is not zero")
+			} else {
+				assert.Nil(t, err, "Expected no error, got %v", err)
+				assert.Equal(t, tt.want, w.String())
+			}
+		})
+	}
+}
+
+func TestWeekMarshalJSON(t *testing.T) {
+	tests := []struct {
+		week Week
+		want string
+	}{
+		{MustParseWeek("2024-W11"), `"2024-W11"`},
+		{ZeroWeek(), `"0001-W01"`},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Week{"%s"}.MarshalJSON()`, tt.week)
+
+		t.Run(testcase, func(t *testing.T) {
+			json, err := tt.week.MarshalJSON()
+			assert.Nil(t, err, "Excepted no error, got %v", err)
+			assert.Equal(t, tt.want, string(json))
+		})
+	}
+}
+
+func TestWeekUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		json []byte
+		want string
+	}{
+		{[]byte(`"2024-W11"`), "2024-W11"},
+		{[]byte{}, "error"},
+		{[]byte("invalid"), "error"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf("Week{}.UnmarshalJSON(`%s`)", tt.json)
+
+		t.Run(testcase, func(t *testing.T) {
+			w := ZeroWeek()
+			err := w.UnmarshalJSON(tt.json)
+			if tt.want == "error" {
+				assert.Error(t, err, "Unable to parse")
+				assert.True(t, w.IsZero(), "week is not zero")
+			} else {
+				assert.Nil(t, err, "Expected no error, got %v", err)
+				assert.Equal(t, tt.want, w.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,355 @@
+package date
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrEndYearIsBeforeStartYear = fmt.Errorf("end year is before start year")
+	ErrInvalidYear              = fmt.Errorf("invalid year")
+)
+
+type Year struct {
+	y int // year -1
+}
+
+// Factory functions
+// --------------------------------------------------
+
+// NewYear creates a new Year instance with the specified year.
+func NewYear(year int) Year {
+	return Year{year - 1}
+}
+
+// ZeroYear returns a zero value Year instance.
+func ZeroYear() Year {
+	return Year{}
+}
+
+// YearFromDate creates a new Year instance from a Date instance.
+func YearFromDate(date Date) Year {
+	return NewYear(date.Year())
+}
+
+// YearFromTime creates a new Year instance from a time.Time value.
+func YearFromTime(time time.Time) Year {
+	return NewYear(time.Year())
+}
+
+// ParseYear parses a year string in the format "2006" and returns a Year instance.
+func ParseYear(value string) (Year, error) {
+	digits := strings.TrimPrefix(value, "-")
+	if len(digits) < 4 || strings.ContainsAny(digits, "+-") {
+		return ZeroYear(), fmt.Errorf("ParseYear: failed to parse year %q: %w", value, ErrInvalidYear)
+	}
+
+	y, err := strconv.Atoi(value)
+	if err != nil {
+		return ZeroYear(), fmt.Errorf("ParseYear: failed to parse year %q: %w", value, ErrInvalidYear)
+	}
+
+	return NewYear(y), nil
+}
+
+// MustParseYear parses a year string in the format "2006" and returns a Year instance.
+// It panics if the parsing fails.
+func MustParseYear(value string) Year {
+	y, err := ParseYear(value)
+	if err != nil {
+		panic(err)
+	}
+
+	return y
+}
+
+// CurrentYear returns the current year.
+func CurrentYear() Year {
+	return YearFromDate(Today())
+}
+
+// NextYear returns the next year.
+func NextYear() Year {
+	return CurrentYear().AddYear()
+}
+
+// LastYear returns the previous year.
+func LastYear() Year {
+	return CurrentYear().SubYear()
+}
+
+// Determination methods
+// --------------------------------------------------
+
+// IsZero checks if the Year instance is a zero value.
+func (y Year) IsZero() bool {
+	zy := ZeroYear()
+
+	return y.Equal(zy)
+}
+
+// IsLeap checks if the Year instance is a leap year in the proleptic Gregorian calendar.
+func (y Year) IsLeap() bool {
+	year := y.Year()
+
+	return year%4 == 0 && (year%100 != 0 || year%400 == 0)
+}
+
+// IsPast checks if the Year instance is in the past.
+func (y Year) IsPast() bool {
+	return y.IsPastWith(DefaultClock())
+}
+
+// IsPastWith checks if the Year instance is in the past according to the specified Clock.
+func (y Year) IsPastWith(c Clock) bool {
+	return y.Before(YearFromDate(c.Today()))
+}
+
+// IsFuture checks if the Year instance is in the future.
+func (y Year) IsFuture() bool {
+	return y.IsFutureWith(DefaultClock())
+}
+
+// IsFutureWith checks if the Year instance is in the future according to the specified Clock.
+func (y Year) IsFutureWith(c Clock) bool {
+	return y.After(YearFromDate(c.Today()))
+}
+
+// IsCurrentYear checks if the Year instance is the current year.
+func (y Year) IsCurrentYear() bool {
+	return y.IsCurrentYearWith(DefaultClock())
+}
+
+// IsCurrentYearWith checks if the Year instance is the current year according to the specified Clock.
+func (y Year) IsCurrentYearWith(c Clock) bool {
+	return y.Equal(YearFromDate(c.Today()))
+}
+
+// IsNextYear checks if the Year instance is the next year.
+func (y Year) IsNextYear() bool {
+	return y.IsNextYearWith(DefaultClock())
+}
+
+// IsNextYearWith checks if the Year instance is the next year according to the specified Clock.
+func (y Year) IsNextYearWith(c Clock) bool {
+	return y.Equal(YearFromDate(c.Today()).AddYear())
+}
+
+// IsLastYear checks if the Year instance is the previous year.
+func (y Year) IsLastYear() bool {
+	return y.IsLastYearWith(DefaultClock())
+}
+
+// IsLastYearWith checks if the Year instance is the previous year according to the specified Clock.
+func (y Year) IsLastYearWith(c Clock) bool {
+	return y.Equal(YearFromDate(c.Today()).SubYear())
+}
+
+// Comparison methods
+// --------------------------------------------------
+
+// Compare compares the Year instance with another Year instance.
+// It returns 1 if the Year instance is after the other Year, 0 if they are equal, and -1 if it is before.
+func (y Year) Compare(year Year) int {
+	if y.After(year) {
+		return 1
+	} else if y.Equal(year) {
+		return 0
+	}
+
+	return -1
+}
+
+// Equal checks if the Year instance is equal to another Year instance.
+func (y Year) Equal(year Year) bool {
+	return y.y == year.y
+}
+
+// NotEqual checks if the Year instance is not equal to another Year instance.
+func (y Year) NotEqual(year Year) bool {
+	return !y.Equal(year)
+}
+
+// After checks if the Year instance is after another Year instance.
+func (y Year) After(year Year) bool {
+	return y.y > year.y
+}
+
+// AfterOrEqual checks if the Year instance is after or equal to another Year instance.
+func (y Year) AfterOrEqual(year Year) bool {
+	return y.Equal(year) || y.After(year)
+}
+
+// Before checks if the Year instance is before another Year instance.
+func (y Year) Before(year Year) bool {
+	return y.y < year.y
+}
+
+// BeforeOrEqual checks if the Year instance is before or equal to another Year instance.
+func (y Year) BeforeOrEqual(year Year) bool {
+	return y.Equal(year) || y.Before(year)
+}
+
+// Between checks if the Year instance is between two other Year instances.
+func (y Year) Between(start, end Year) (bool, error) {
+	if start.After(end) {
+		return false, fmt.Errorf(
+			"Between: end year %v is before start year %v: %w",
+			end,
+			start,
+			ErrEndYearIsBeforeStartYear,
+		)
+	}
+
+	return start.BeforeOrEqual(y) && end.AfterOrEqual(y), nil
+}
+
+// Addition and Subtraction methods
+// --------------------------------------------------
+
+// AddYear adds one year to the Year instance.
+func (y Year) AddYear() Year {
+	return y.AddYears(1)
+}
+
+// AddYears adds the specified number of years to the Year instance.
+func (y Year) AddYears(years int) Year {
+	return Year{y.y + years}
+}
+
+// SubYear subtracts one year from the Year instance.
+func (y Year) SubYear() Year {
+	return y.SubYears(1)
+}
+
+// SubYears subtracts the specified number of years from the Year instance.
+func (y Year) SubYears(years int) Year {
+	return y.AddYears(years * -1)
+}
+
+// Conversion methods
+// --------------------------------------------------
+
+// Year returns the year of the Year instance as an int.
+func (y Year) Year() int {
+	return y.y + 1
+}
+
+// FirstDate returns the first date, which is January 1, of the Year instance.
+func (y Year) FirstDate() Date {
+	return NewDate(y.Year(), time.January, 1)
+}
+
+// LastDate returns the last date, which is December 31, of the Year instance.
+func (y Year) LastDate() Date {
+	return NewDate(y.Year(), time.December, 31)
+}
+
+// On returns the Date of the specified month and day in the Year instance.
+func (y Year) On(month time.Month, day int) Date {
+	return NewDate(y.Year(), month, day)
+}
+
+// ToDateRange converts the Year instance to a DateRange instance.
+func (y Year) ToDateRange() DateRange {
+	r, _ := NewDateRange(y.FirstDate(), y.LastDate())
+
+	return r
+}
+
+// Days returns the number of days in the Year instance, which is 365 or 366.
+func (y Year) Days() int {
+	if y.IsLeap() {
+		return 366
+	}
+
+	return 365
+}
+
+// Dates returns the Dates within the Year instance.
+func (y Year) Dates() Dates {
+	return y.ToDateRange().Dates()
+}
+
+// Months returns the twelve months of the Year instance.
+func (y Year) Months() []Month {
+	months := make([]Month, 12)
+	for i := range months {
+		months[i] = Month{y.y, i}
+	}
+
+	return months
+}
+
+// Quarters returns the four quarters of the Year instance.
+func (y Year) Quarters() []Quarter {
+	quarters := make([]Quarter, 4)
+	for i := range quarters {
+		quarters[i] = Quarter{y.y, i}
+	}
+
+	return quarters
+}
+
+// Weeks returns the ISO 8601 weeks belonging to the Year instance, which number 52 or 53.
+// The first and last weeks may include dates of the adjacent years.
+func (y Year) Weeks() []Week {
+	weeks := make([]Week, isoWeeksInYear(y.Year()))
+	for i := range weeks {
+		weeks[i] = Week{y.y, i}
+	}
+
+	return weeks
+}
+
+// String returns the string representation of the Year instance in the format "2006".
+func (y Year) String() string {
+	f := "%04d"
+	if y.Year() < 0 {
+		f = "%05d"
+	}
+
+	return fmt.Sprintf(f, y.Year())
+}
+
+// Marshalling methods
+// --------------------------------------------------
+
+// MarshalText marshals the Year instance to a text representation.
+func (y *Year) MarshalText() ([]byte, error) {
+	return []byte(y.String()), nil
+}
+
+// UnmarshalText unmarshals a text representation into the Year instance.
+func (y *Year) UnmarshalText(text []byte) error {
+	year, err := ParseYear(string(text))
+	if err != nil {
+		return fmt.Errorf("Year.UnmarshalText: %w", err)
+	}
+
+	y.y = year.y
+
+	return nil
+}
+
+// MarshalJSON marshals the Year instance to a JSON representation.
+// Years are encoded as strings like the other types, so that "0001" keeps its zero padding.
+func (y Year) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + y.String() + `"`), nil
+}
+
+// UnmarshalJSON unmarshals a JSON representation into the Year instance.
+func (y *Year) UnmarshalJSON(json []byte) error {
+	value := strings.Trim(string(json), `"`)
+
+	year, err := ParseYear(value)
+	if err != nil {
+		return fmt.Errorf("Year.UnmarshalJSON: %w", err)
+	}
+
+	y.y = year.y
+
+	return nil
+}
@@ -0,0 +1,580 @@
+package date
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Factory functions
+// --------------------------------------------------
+
+func TestNewYear(t *testing.T) {
+	tests := []struct {
+		year int
+		want string
+	}{
+		{2024, "2024"},
+		{1, "0001"},
+		{-1, "-0001"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf("NewYear(%d)", tt.year)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, NewYear(tt.year).String())
+		})
+	}
+}
+
+func TestZeroYear(t *testing.T) {
+	t.Run("ZeroYear()", func(t *testing.T) {
+		year := ZeroYear()
+		assert.True(t, year.IsZero(), "Year created was not zero value")
+		assert.Equal(t, "0001", year.String())
+		assert.Equal(t, "0001-01-01", year.FirstDate().String())
+	})
+}
+
+func TestYearFromDate(t *testing.T) {
+	tests := []struct {
+		date Date
+		want string
+	}{
+		{MustParse("2024-01-01"), "2024"},
+		{MustParse("2024-12-31"), "2024"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`YearFromDate(Date{"%s"})`, tt.date)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, YearFromDate(tt.date).String())
+		})
+	}
+}
+
+func TestYearFromTime(t *testing.T) {
+	tests := []struct {
+		time time.Time
+		want string
+	}{
+		{time.Date(2024, time.March, 11, 0, 0, 0, 0, time.UTC), "2024"},
+		{time.Date(2023, time.December, 31, 23, 59, 59, 0, time.Local), "2023"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`YearFromTime(Time{%s})`, tt.time.Format(iso8601))
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, YearFromTime(tt.time).String())
+		})
+	}
+}
+
+func TestParseYear(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    string
+		wantErr error
+	}{
+		{"2024", "2024", nil},
+		{"0001", "0001", nil},
+		{"-0001", "-0001", nil},
+		{"12345", "12345", nil},
+		{"24", "", ErrInvalidYear},
+		{"+2024", "", ErrInvalidYear},
+		{"2024-01", "", ErrInvalidYear},
+		{"abcd", "", ErrInvalidYear},
+		{"", "", ErrInvalidYear},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`ParseYear("%s")`, tt.value)
+
+		t.Run(testcase, func(t *testing.T) {
+			y, err := ParseYear(tt.value)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.True(t, y.IsZero(), "year is not zero")
+			} else {
+				assert.Nil(t, err, "Expected no error, got %v", err)
+				assert.Equal(t, tt.want, y.String())
+			}
+		})
+	}
+}
+
+func TestMustParseYear(t *testing.T) {
+	tests := []struct {
+		value     string
+		wantPanic bool
+	}{
+		{"2024", false},
+		{"invalid", true},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`MustParseYear("%s")`, tt.value)
+
+		t.Run(testcase, func(t *testing.T) {
+			if tt.wantPanic {
+				assert.Panics(t, func() { MustParseYear(tt.value) })
+			} else {
+				assert.Equal(t, tt.value, MustParseYear(tt.value).String())
+			}
+		})
+	}
+}
+
+func TestCurrentYear(t *testing.T) {
+	tests := []struct {
+		now  time.Time
+		want string
+	}{
+		{time.Date(2024, time.January, 1, 0, 0, 0, 0, time.Local), "2024"},
+		{time.Date(2023, time.December, 31, 23, 59, 59, 999, time.Local), "2023"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`CurrentYear() at %s`, tt.now.Format(iso8601))
+
+		t.Run(testcase, func(t *testing.T) {
+			SetTestNow(func() time.Time { return tt.now })
+			defer ResetTestNow()
+
+			assert.Equal(t, tt.want, CurrentYear().String())
+		})
+	}
+}
+
+func TestNextYear(t *testing.T) {
+	t.Run("NextYear()", func(t *testing.T) {
+		SetTestNow(func() time.Time { return time.Date(2024, time.December, 31, 0, 0, 0, 0, time.Local) })
+		defer ResetTestNow()
+
+		assert.Equal(t, "2025", NextYear().String())
+	})
+}
+
+func TestLastYear(t *testing.T) {
+	t.Run("LastYear()", func(t *testing.T) {
+		SetTestNow(func() time.Time { return time.Date(2024, time.January, 1, 0, 0, 0, 0, time.Local) })
+		defer ResetTestNow()
+
+		assert.Equal(t, "2023", LastYear().String())
+	})
+}
+
+// Determination methods
+// --------------------------------------------------
+
+func TestYearIsZero(t *testing.T) {
+	tests := []struct {
+		year Year
+		want bool
+	}{
+		{ZeroYear(), true},
+		{MustParseYear("2024"), false},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Year{"%s"}.IsZero()`, tt.year)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.year.IsZero())
+		})
+	}
+}
+
+func TestYearIsLeap(t *testing.T) {
+	tests := []struct {
+		year Year
+		want bool
+	}{
+		{NewYear(2024), true},
+		{NewYear(2023), false},
+		{NewYear(2000), true},
+		{NewYear(1900), false},
+		{NewYear(0), true},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Year{"%s"}.IsLeap()`, tt.year)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.year.IsLeap())
+		})
+	}
+}
+
+func TestYearRelativeDeterminations(t *testing.T) {
+	tests := []struct {
+		year                              Year
+		past, future, current, next, last bool
+	}{
+		{NewYear(2023), true, false, false, false, true},
+		{NewYear(2024), false, false, true, false, false},
+		{NewYear(2025), false, true, false, true, false},
+		{NewYear(2026), false, true, false, false, false},
+	}
+
+	for _, tt := range tests {
+		SetTestNow(func() time.Time { return time.Date(2024, time.March, 13, 12, 0, 0, 0, time.Local) })
+		defer ResetTestNow()
+
+		testcase := fmt.Sprintf(`Year{"%s"} at %s`, tt.year, now().Format(iso8601))
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.past, tt.year.IsPast(), "IsPast")
+			assert.Equal(t, tt.future, tt.year.IsFuture(), "IsFuture")
+			assert.Equal(t, tt.current, tt.year.IsCurrentYear(), "IsCurrentYear")
+			assert.Equal(t, tt.next, tt.year.IsNextYear(), "IsNextYear")
+			assert.Equal(t, tt.last, tt.year.IsLastYear(), "IsLastYear")
+		})
+	}
+}
+
+// Comparison methods
+// --------------------------------------------------
+
+func TestYearComparisons(t *testing.T) {
+	tests := []struct {
+		year, target                                             Year
+		compare                                                  int
+		equal, after, afterOrEqual, before, beforeOrEqual, notEq bool
+	}{
+		{NewYear(2024), NewYear(2024), 0, true, false, true, false, true, false},
+		{NewYear(2024), NewYear(2023), 1, false, true, true, false, false, true},
+		{NewYear(2024), NewYear(2025), -1, false, false, false, true, true, true},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Year{"%s"} vs Year{"%s"}`, tt.year, tt.target)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.compare, tt.year.Compare(tt.target), "Compare")
+			assert.Equal(t, tt.equal, tt.year.Equal(tt.target), "Equal")
+			assert.Equal(t, tt.notEq, tt.year.NotEqual(tt.target), "NotEqual")
+			assert.Equal(t, tt.after, tt.year.After(tt.target), "After")
+			assert.Equal(t, tt.afterOrEqual, tt.year.AfterOrEqual(tt.target), "AfterOrEqual")
+			assert.Equal(t, tt.before, tt.year.Before(tt.target), "Before")
+			assert.Equal(t, tt.beforeOrEqual, tt.year.BeforeOrEqual(tt.target), "BeforeOrEqual")
+		})
+	}
+}
+
+func TestYearBetween(t *testing.T) {
+	tests := []struct {
+		year    Year
+		start   Year
+		end     Year
+		want    bool
+		wantErr error
+	}{
+		{NewYear(2024), NewYear(2023), NewYear(2025), true, nil},
+		{NewYear(2024), NewYear(2024), NewYear(2024), true, nil},
+		{NewYear(2024), NewYear(2025), NewYear(2026), false, nil},
+		{NewYear(2024), NewYear(2025), NewYear(2023), false, ErrEndYearIsBeforeStartYear},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Year{"%s"}.Between(Year{"%s"},Year{"%s"})`, tt.year, tt.start, tt.end)
+
+		t.Run(testcase, func(t *testing.T) {
+			b, err := tt.year.Between(tt.start, tt.end)
+			assert.Equal(t, tt.want, b)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.Nil(t, err, "Expected no error, got %v", err)
+			}
+		})
+	}
+}
+
+// Addition and Subtraction methods
+// --------------------------------------------------
+
+func TestYearAddAndSubYears(t *testing.T) {
+	y := NewYear(2024)
+
+	t.Run(`Year{"2024"}.AddYear()`, func(t *testing.T) {
+		assert.Equal(t, "2025", y.AddYear().String())
+	})
+	t.Run(`Year{"2024"}.AddYears(10)`, func(t *testing.T) {
+		assert.Equal(t, "2034", y.AddYears(10).String())
+	})
+	t.Run(`Year{"2024"}.SubYear()`, func(t *testing.T) {
+		assert.Equal(t, "2023", y.SubYear().String())
+	})
+	t.Run(`Year{"2024"}.SubYears(-2)`, func(t *testing.T) {
+		assert.Equal(t, "2026", y.SubYears(-2).String())
+	})
+}
+
+// Conversion methods
+// --------------------------------------------------
+
+func TestYearYear(t *testing.T) {
+	t.Run(`Year{"2024"}.Year()`, func(t *testing.T) {
+		assert.Equal(t, 2024, NewYear(2024).Year())
+	})
+}
+
+func TestYearFirstDateAndLastDate(t *testing.T) {
+	tests := []struct {
+		year        Year
+		first, last string
+	}{
+		{NewYear(2024), "2024-01-01", "2024-12-31"},
+		{ZeroYear(), "0001-01-01", "0001-12-31"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Year{"%s"}.FirstDate() and LastDate()`, tt.year)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.first, tt.year.FirstDate().String(), "FirstDate")
+			assert.Equal(t, tt.last, tt.year.LastDate().String(), "LastDate")
+		})
+	}
+}
+
+func TestYearOn(t *testing.T) {
+	tests := []struct {
+		year  Year
+		month time.Month
+		day   int
+		want  string
+	}{
+		{NewYear(2024), time.February, 29, "2024-02-29"},
+		{NewYear(2023), time.February, 29, "2023-03-01"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Year{"%s"}.On(%s, %d)`, tt.year, tt.month, tt.day)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.year.On(tt.month, tt.day).String())
+		})
+	}
+}
+
+func TestYearToDateRange(t *testing.T) {
+	t.Run(`Year{"2024"}.ToDateRange()`, func(t *testing.T) {
+		assert.Equal(t, "2024-01-01/2024-12-31", NewYear(2024).ToDateRange().String())
+	})
+}
+
+func TestYearDays(t *testing.T) {
+	tests := []struct {
+		year Year
+		want int
+	}{
+		{NewYear(2024), 366},
+		{NewYear(2023), 365},
+		{NewYear(1900), 365},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Year{"%s"}.Days()`, tt.year)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.year.Days())
+			assert.Equal(t, tt.want, tt.year.ToDateRange().Days())
+		})
+	}
+}
+
+func TestYearDates(t *testing.T) {
+	t.Run(`Year{"2023"}.Dates()`, func(t *testing.T) {
+		dates := NewYear(2023).Dates()
+
+		assert.Len(t, dates, 365)
+		assert.Equal(t, "2023-01-01", dates[0].String())
+		assert.Equal(t, "2023-12-31", dates[364].String())
+	})
+}
+
+func TestYearMonths(t *testing.T) {
+	t.Run(`Year{"2024"}.Months()`, func(t *testing.T) {
+		months := NewYear(2024).Months()
+
+		assert.Len(t, months, 12)
+		assert.Equal(t, "2024-01", months[0].String())
+		assert.Equal(t, "2024-06", months[5].String())
+		assert.Equal(t, "2024-12", months[11].String())
+	})
+}
+
+func TestYearQuarters(t *testing.T) {
+	t.Run(`Year{"2024"}.Quarters()`, func(t *testing.T) {
+		quarters := NewYear(2024).Quarters()
+
+		assert.Len(t, quarters, 4)
+		assert.Equal(t, "2024-Q1", quarters[0].String())
+		assert.Equal(t, "2024-Q4", quarters[3].String())
+	})
+}
+
+func TestYearWeeks(t *testing.T) {
+	tests := []struct {
+		year        Year
+		count       int
+		first, last string
+	}{
+		{NewYear(2024), 52, "2024-01-01", "2024-12-29"},
+		{NewYear(2020), 53, "2019-12-30", "2021-01-03"},
+		{NewYear(2021), 52, "2021-01-04", "2022-01-02"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Year{"%s"}.Weeks()`, tt.year)
+
+		t.Run(testcase, func(t *testing.T) {
+			weeks := tt.year.Weeks()
+
+			assert.Len(t, weeks, tt.count)
+			assert.Equal(t, tt.year.Year(), weeks[0].Year())
+			assert.Equal(t, tt.first, weeks[0].FirstDate().String())
+			assert.Equal(t, tt.last, weeks[len(weeks)-1].LastDate().String())
+		})
+	}
+}
+
+func TestYearString(t *testing.T) {
+	tests := []struct {
+		year Year
+		want string
+	}{
+		{NewYear(2024), "2024"},
+		{NewYear(12), "0012"},
+		{NewYear(-12), "-0012"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Year{%d}.String()`, tt.year.Year())
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.year.String())
+		})
+	}
+}
+
+func TestDateToYear(t *testing.T) {
+	tests := []struct {
+		date Date
+		want Year
+	}{
+		{MustParse("2024-03-13"), NewYear(2024)},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Date{"%s"}.ToYear()`, tt.date)
+
+		t.Run(testcase, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.date.ToYear())
+		})
+	}
+}
+
+// Marshalling methods
+// --------------------------------------------------
+
+func TestYearMarshalText(t *testing.T) {
+	tests := []struct {
+		year Year
+		want string
+	}{
+		{NewYear(2024), "2024"},
+		{ZeroYear(), "0001"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Year{"%s"}.MarshalText()`, tt.year)
+
+		t.Run(testcase, func(t *testing.T) {
+			text, err := tt.year.MarshalText()
+			assert.Nil(t, err, "Excepted no error, got %v", err)
+			assert.Equal(t, tt.want, string(text))
+		})
+	}
+}
+
+func TestYearUnmarshalText(t *testing.T) {
+	tests := []struct {
+		text []byte
+		want string
+	}{
+		{[]byte("2024"), "2024"},
+		{[]byte{}, "error"},
+		{[]byte("invalid"), "error"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Year{}.UnmarshalText("%s")`, tt.text)
+
+		t.Run(testcase, func(t *testing.T) {
+			y := ZeroYear()
+			err := y.UnmarshalText(tt.text)
+			if tt.want == "error" {
+				assert.Error(t, err, "Unable to parse")
+				assert.True(t, y.IsZero(), "year is not zero")
+			} else {
+				assert.Nil(t, err, "Expected no error, got %v", err)
+				assert.Equal(t, tt.want, y.String())
+			}
+		})
+	}
+}
+
+func TestYearMarshalJSON(t *testing.T) {
+	tests := []struct {
+		year Year
+		want string
+	}{
+		{NewYear(2024), `"2024"`},
+		{ZeroYear(), `"0001"`},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf(`Year{"%s"}.MarshalJSON()`, tt.year)
+
+		t.Run(testcase, func(t *testing.T) {
+			json, err := tt.year.MarshalJSON()
+			assert.Nil(t, err, "Excepted no error, got %v", err)
+			assert.Equal(t, tt.want, string(json))
+		})
+	}
+}
+
+func TestYearUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		json []byte
+		want string
+	}{
+		{[]byte(`"2024"`), "2024"},
+		{[]byte{}, "error"},
+		{[]byte("invalid"), "error"},
+	}
+
+	for _, tt := range tests {
+		testcase := fmt.Sprintf("Year{}.UnmarshalJSON(`%s`)", tt.json)
+
+		t.Run(testcase, func(t *testing.T) {
+			y := ZeroYear()
+			err := y.UnmarshalJSON(tt.json)
+			if tt.want == "error" {
+				assert.Error(t, err, "Unable to parse")
+				assert.True(t, y.IsZero(), "year is not zero")
+			} else {
+				assert.Nil(t, err, "Expected no error, got %v", err)
+				assert.Equal(t, tt.want, y.String())
+			}
+		})
+	}
+}